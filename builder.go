@@ -0,0 +1,435 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// EventBuilder is a chained alternative to the options pattern for building and logging
+// an event, e.g., logger.AtInfo().Str("host", host).Int("port", port).Msg("connected").
+// It is obtained from Logger.At (or one of its level-specific shortcuts), collects the
+// event's metadata through its methods, and logs the event when Msg, Msgf, or Send is
+// called, through the same pipeline as the level-specific logging methods. The builder
+// may be kept across several statements to build the event conditionally.
+//
+// If the level is disabled, At returns a nil *EventBuilder, on which every method is a
+// no-op that does not allocate, so the chain costs next to nothing. A builder must not
+// be used after Msg, Msgf, or Send, as it is reused for later events, and must not be
+// used from multiple goroutines.
+//
+// Fields:
+//   - logger (*Logger): The Logger the event is logged with.
+//   - event (*Event): The event being built, taken from _EventPool.
+//   - ctx (context.Context): The context whose metadata is added when the event is
+//     logged, set with Ctx.
+type EventBuilder struct {
+	logger *Logger
+	event  *Event
+	ctx    context.Context
+}
+
+// Str adds a string to the event's metadata. See WithString.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (string): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Str(key, value string) (builder *EventBuilder) {
+	if b != nil {
+		b.event.SetString(key, value)
+	}
+
+	builder = b
+
+	return
+}
+
+// Int adds an int to the event's metadata. See WithInt.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (int): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Int(key string, value int) (builder *EventBuilder) {
+	builder = b.apply(WithInt(key, value))
+
+	return
+}
+
+// Int64 adds an int64 to the event's metadata. See WithInt64.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (int64): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Int64(key string, value int64) (builder *EventBuilder) {
+	builder = b.apply(WithInt64(key, value))
+
+	return
+}
+
+// Float64 adds a float64 to the event's metadata. See WithFloat64.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (float64): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Float64(key string, value float64) (builder *EventBuilder) {
+	builder = b.apply(WithFloat64(key, value))
+
+	return
+}
+
+// Bool adds a bool to the event's metadata. See WithBool.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (bool): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Bool(key string, value bool) (builder *EventBuilder) {
+	builder = b.apply(WithBool(key, value))
+
+	return
+}
+
+// Duration adds a time.Duration to the event's metadata. See WithDuration.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (time.Duration): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Duration(key string, value time.Duration) (builder *EventBuilder) {
+	builder = b.apply(WithDuration(key, value))
+
+	return
+}
+
+// Time adds a time.Time to the event's metadata. See WithTime.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (time.Time): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Time(key string, value time.Time) (builder *EventBuilder) {
+	builder = b.apply(WithTime(key, value))
+
+	return
+}
+
+// Stringer adds a fmt.Stringer to the event's metadata. See WithStringer.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (fmt.Stringer): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Stringer(key string, value fmt.Stringer) (builder *EventBuilder) {
+	builder = b.apply(WithStringer(key, value))
+
+	return
+}
+
+// Bytes adds a byte slice holding text to the event's metadata. See WithBytes.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value ([]byte): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Bytes(key string, value []byte) (builder *EventBuilder) {
+	builder = b.apply(WithBytes(key, value))
+
+	return
+}
+
+// Any adds a value of any type to the event's metadata. See WithValue.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (any): The metadata value.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Any(key string, value any) (builder *EventBuilder) {
+	builder = b.apply(WithValue(key, value))
+
+	return
+}
+
+// Err adds an error to the event's metadata under the "error" key. See WithError.
+//
+// Parameters:
+//   - err (error): The error to add.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Err(err error) (builder *EventBuilder) {
+	builder = b.apply(WithError(err))
+
+	return
+}
+
+// Label sets the event's label, overriding the default label of its level. See
+// WithLabel.
+//
+// Parameters:
+//   - label (string): The label to set.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Label(label string) (builder *EventBuilder) {
+	builder = b.apply(WithLabel(label))
+
+	return
+}
+
+// ExitCode sets the status code the program exits with if the event is at LevelFatal.
+// See WithExitCode.
+//
+// Parameters:
+//   - code (int): The exit status code.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) ExitCode(code int) (builder *EventBuilder) {
+	builder = b.apply(WithExitCode(code))
+
+	return
+}
+
+// Ctx sets the context whose metadata (see ContextWithFields) is added to the event
+// when it is logged, for keys the builder does not set.
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Ctx(ctx context.Context) (builder *EventBuilder) {
+	if b != nil {
+		b.ctx = ctx
+	}
+
+	builder = b
+
+	return
+}
+
+// Apply applies options to the event, allowing options written for the level-specific
+// logging methods to be reused with the builder.
+//
+// Parameters:
+//   - ofs (...OptionFunc): The options to apply.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) Apply(ofs ...OptionFunc) (builder *EventBuilder) {
+	if b != nil {
+		for _, f := range ofs {
+			f(b.event)
+		}
+	}
+
+	builder = b
+
+	return
+}
+
+// Msg logs the event with the given message and releases the builder.
+//
+// Parameters:
+//   - message (string): The log message.
+func (b *EventBuilder) Msg(message string) {
+	if b == nil {
+		return
+	}
+
+	b.event.SetMessage(message)
+
+	b.send()
+}
+
+// Msgf logs the event with a message formatted with fmt.Sprintf and releases the
+// builder. The message is not formatted if the level is disabled.
+//
+// Parameters:
+//   - format (string): The format of the log message.
+//   - args (...any): The arguments of the format.
+func (b *EventBuilder) Msgf(format string, args ...any) {
+	if b == nil {
+		return
+	}
+
+	b.event.SetMessage(fmt.Sprintf(format, args...))
+
+	b.send()
+}
+
+// Send logs the event without a message and releases the builder.
+func (b *EventBuilder) Send() {
+	if b == nil {
+		return
+	}
+
+	b.send()
+}
+
+// apply applies a single option to the event; it is a no-op on a nil builder.
+//
+// Parameters:
+//   - of (OptionFunc): The option to apply.
+//
+// Returns:
+//   - builder (*EventBuilder): The receiver, for chaining.
+func (b *EventBuilder) apply(of OptionFunc) (builder *EventBuilder) {
+	if b != nil {
+		of(b.event)
+	}
+
+	builder = b
+
+	return
+}
+
+// send adds the context metadata, passes the event to the logger, and returns the
+// event and the builder to their pools. It must be called directly by Msg, Msgf, or
+// Send, so that the captured caller is the code calling them.
+func (b *EventBuilder) send() {
+	_AddContextMetadata(b.ctx, b.event)
+
+	b.logger.emit(b.event, 2)
+
+	_ReleaseEvent(b.event)
+
+	*b = EventBuilder{}
+
+	_EventBuilderPool.Put(b)
+}
+
+// _EventBuilderPool holds builders reused by Logger.At.
+var _EventBuilderPool = sync.Pool{
+	New: func() any {
+		return &EventBuilder{}
+	},
+}
+
+// At returns an EventBuilder for an event at the given level, or nil if the level is
+// disabled (see Enabled), in which case the whole chain is a no-op.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level of the event.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) At(level hqgologgerlevels.Level) (builder *EventBuilder) {
+	if level > l.core.threshold(l.name) {
+		return
+	}
+
+	pipeline := l.core.pipeline(l.name)
+
+	if _, accepted := pipeline.accepts(level); !accepted {
+		return
+	}
+
+	builder, _ = _EventBuilderPool.Get().(*EventBuilder)
+
+	builder.logger = l
+	builder.event = _AcquireEvent(pipeline.clock.Now())
+	builder.ctx = context.Background()
+
+	builder.event.SetLevel(level)
+
+	return
+}
+
+// AtFatal returns an EventBuilder for an event at LevelFatal. After the event is
+// logged, the program exits as described for Fatal. See At.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) AtFatal() (builder *EventBuilder) {
+	builder = l.At(hqgologgerlevels.LevelFatal)
+
+	return
+}
+
+// AtPrint returns an EventBuilder for an event at LevelSilent. See At.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) AtPrint() (builder *EventBuilder) {
+	builder = l.At(hqgologgerlevels.LevelSilent)
+
+	return
+}
+
+// AtError returns an EventBuilder for an event at LevelError. See At.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) AtError() (builder *EventBuilder) {
+	builder = l.At(hqgologgerlevels.LevelError)
+
+	return
+}
+
+// AtInfo returns an EventBuilder for an event at LevelInfo. See At.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) AtInfo() (builder *EventBuilder) {
+	builder = l.At(hqgologgerlevels.LevelInfo)
+
+	return
+}
+
+// AtWarn returns an EventBuilder for an event at LevelWarn. See At.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) AtWarn() (builder *EventBuilder) {
+	builder = l.At(hqgologgerlevels.LevelWarn)
+
+	return
+}
+
+// AtDebug returns an EventBuilder for an event at LevelDebug. See At.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) AtDebug() (builder *EventBuilder) {
+	builder = l.At(hqgologgerlevels.LevelDebug)
+
+	return
+}
+
+// AtTrace returns an EventBuilder for an event at LevelTrace. See At.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func (l *Logger) AtTrace() (builder *EventBuilder) {
+	builder = l.At(hqgologgerlevels.LevelTrace)
+
+	return
+}
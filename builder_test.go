@@ -0,0 +1,153 @@
+package logger_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestEventBuilderFields(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	at := time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC)
+	failure := errors.New("connection reset")
+	ctx := hqgologger.ContextWithFields(context.Background(), hqgologger.WithString("request_id", "42"))
+
+	logger.AtWarn().
+		Str("host", "example.com").
+		Int("port", 8080).
+		Int64("bytes", 1<<40).
+		Float64("ratio", 0.5).
+		Bool("tls", true).
+		Duration("elapsed", 1500*time.Millisecond).
+		Time("at", at).
+		Stringer("addr", netip.MustParseAddr("10.0.0.1")).
+		Bytes("body", []byte("ok")).
+		Any("tags", []string{"a", "b"}).
+		Err(failure).
+		Label("NET").
+		Ctx(ctx).
+		Apply(hqgologger.WithString("applied", "yes")).
+		Msgf("connected to %s", "example.com")
+
+	entries := recorder.All()
+
+	if len(entries) != 1 {
+		t.Fatalf("recorded %d entries, want 1", len(entries))
+	}
+
+	entry := entries[0]
+
+	if entry.Level != hqgologgerlevels.LevelWarn || entry.Message != "connected to example.com" {
+		t.Errorf("logged %v %q, want %v %q", entry.Level, entry.Message, hqgologgerlevels.LevelWarn, "connected to example.com")
+	}
+
+	want := map[string]any{
+		"host":       "example.com",
+		"port":       int64(8080),
+		"bytes":      int64(1 << 40),
+		"ratio":      0.5,
+		"tls":        true,
+		"elapsed":    1500 * time.Millisecond,
+		"at":         at,
+		"addr":       netip.MustParseAddr("10.0.0.1"),
+		"body":       []byte("ok"),
+		"tags":       []string{"a", "b"},
+		"error":      failure,
+		"label":      "NET",
+		"request_id": "42",
+		"applied":    "yes",
+	}
+
+	for key, value := range want {
+		if got, ok := entry.Value(key); !ok || !reflect.DeepEqual(got, value) {
+			t.Errorf("Value(%q) = %v (%T), want %v (%T)", key, got, got, value, value)
+		}
+	}
+}
+
+func TestEventBuilderSend(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.AtInfo().Str("first", "1").Msg("first")
+	logger.AtInfo().Send()
+	logger.At(hqgologgerlevels.LevelError).Int("second", 2).Msg("second")
+
+	entries := recorder.All()
+
+	if got, want := entries.Messages(), []string{"first", "", "second"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("recorded %q, want %q", got, want)
+	}
+
+	// Builders and events are reused once logged, without carrying over metadata.
+	for _, entry := range entries[1:] {
+		if _, ok := entry.Value("first"); ok {
+			t.Errorf("%q carries the metadata of an earlier event", entry.Message)
+		}
+	}
+
+	if _, ok := entries[2].Value("second"); !ok {
+		t.Errorf("%q lost its own metadata", entries[2].Message)
+	}
+}
+
+// countingStringer counts the calls to its String method.
+type countingStringer struct {
+	calls *atomic.Int64
+}
+
+func (s countingStringer) String() (str string) {
+	s.calls.Add(1)
+
+	str = "formatted"
+
+	return
+}
+
+func TestEventBuilderDisabled(t *testing.T) {
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetLevel(hqgologgerlevels.LevelInfo)
+
+	calls := &atomic.Int64{}
+	stringer := countingStringer{calls: calls}
+
+	if builder := logger.AtDebug(); builder != nil {
+		t.Fatal("AtDebug() returned a builder for a disabled level")
+	}
+
+	logger.AtDebug().Str("k", "v").Stringer("s", stringer).Err(errors.New("e")).Msg("hidden")
+	logger.AtTrace().Msgf("hidden %v", stringer)
+	logger.AtDebug().Send()
+
+	if got := recorder.Len(); got != 0 {
+		t.Errorf("recorded %d entries at disabled levels, want 0", got)
+	}
+
+	if got := calls.Load(); got != 0 {
+		t.Errorf("String called %d times at disabled levels, want 0", got)
+	}
+
+	if raceEnabled {
+		return
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		logger.AtDebug().Str("k", "v").Int("n", 1).Duration("d", time.Second).Msg("hidden")
+	})
+	if allocs != 0 {
+		t.Errorf("disabled chain: got %v allocations per call, want 0", allocs)
+	}
+}
@@ -0,0 +1,133 @@
+package logger_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+// nextLine returns the file of its caller and the line following the call.
+func nextLine() (file string, line int) {
+	_, file, line, _ = runtime.Caller(1)
+
+	line++
+
+	return
+}
+
+// logFromHelper logs through a logging helper, which is skipped with AddCallerSkip so
+// that the caller reported is the helper's caller.
+func logFromHelper(logger *hqgologger.Logger, message string) {
+	logger.AddCallerSkip(1).Info(message)
+}
+
+func TestCallerIsCallSite(t *testing.T) {
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetCaller(true)
+
+	previous := hqgologger.DefaultLogger
+
+	hqgologger.DefaultLogger = logger
+
+	t.Cleanup(func() {
+		hqgologger.DefaultLogger = previous
+	})
+
+	tests := []struct {
+		name string
+		log  func() (file string, line int)
+	}{
+		{
+			name: "method",
+			log: func() (file string, line int) {
+				file, line = nextLine()
+				logger.Info("method")
+
+				return
+			},
+		},
+		{
+			name: "named derived logger",
+			log: func() (file string, line int) {
+				file, line = nextLine()
+				logger.Named("db").With(hqgologger.WithString("k", "v")).Warn("derived")
+
+				return
+			},
+		},
+		{
+			name: "package function",
+			log: func() (file string, line int) {
+				file, line = nextLine()
+				hqgologger.Info("package function")
+
+				return
+			},
+		},
+		{
+			name: "package context function",
+			log: func() (file string, line int) {
+				file, line = nextLine()
+				hqgologger.InfoContext(context.Background(), "package context function")
+
+				return
+			},
+		},
+		{
+			name: "builder msg",
+			log: func() (file string, line int) {
+				file, line = nextLine()
+				logger.AtInfo().Str("k", "v").Msg("builder msg")
+
+				return
+			},
+		},
+		{
+			name: "builder send",
+			log: func() (file string, line int) {
+				file, line = nextLine()
+				hqgologger.At(hqgologgerlevels.LevelInfo).Send()
+
+				return
+			},
+		},
+		{
+			name: "add caller skip",
+			log: func() (file string, line int) {
+				file, line = nextLine()
+				logFromHelper(logger, "add caller skip")
+
+				return
+			},
+		},
+	}
+
+	for _, test := range tests {
+		recorder.TakeAll()
+
+		file, line := test.log()
+
+		entries := recorder.TakeAll()
+
+		if len(entries) != 1 {
+			t.Fatalf("%s: recorded %d entries, want 1", test.name, len(entries))
+		}
+
+		caller := entries[0].Caller
+
+		if caller == nil {
+			t.Errorf("%s: no caller captured", test.name)
+
+			continue
+		}
+
+		if caller.File != file || caller.Line != line {
+			t.Errorf("%s: caller = %s:%d, want %s:%d", test.name, caller.File, caller.Line, file, line)
+		}
+	}
+}
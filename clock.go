@@ -0,0 +1,29 @@
+package logger
+
+import "time"
+
+// Clock provides the current time to a Logger (see Logger.SetClock), which stamps the
+// events it creates with it. Replacing the system clock with a fixed or simulated one
+// makes timestamps deterministic, e.g., for snapshot tests of log output.
+type Clock interface {
+	// Now returns the current time.
+	//
+	// Returns:
+	//   - t (time.Time): The current time.
+	Now() (t time.Time)
+}
+
+// _SystemClock is the Clock reading the system time, used by default.
+type _SystemClock struct{}
+
+// Now returns the current system time.
+//
+// Returns:
+//   - t (time.Time): The current time, as returned by time.Now.
+func (_SystemClock) Now() (t time.Time) {
+	t = time.Now()
+
+	return
+}
+
+var _ Clock = _SystemClock{}
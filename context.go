@@ -0,0 +1,212 @@
+package logger
+
+import (
+	"context"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// _ContextKey is the type of the keys under which the package stores values in a
+// context.Context, preventing collisions with keys defined in other packages.
+type _ContextKey int
+
+const (
+	// _ContextKeyLogger is the key under which ContextWithLogger stores a *Logger.
+	_ContextKeyLogger _ContextKey = iota
+	// _ContextKeyMetadata is the key under which ContextWithFields stores metadata.
+	_ContextKeyMetadata
+)
+
+// FatalContext logs a message at LevelFatal like Fatal, adding the metadata carried by
+// ctx (see ContextWithFields). After writing, the program exits as described for Fatal.
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - message (string): The log message describing the critical failure.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) FatalContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	l.log(ctx, 1, hqgologgerlevels.LevelFatal, message, ofs)
+}
+
+// PrintContext logs a message at LevelSilent like Print, adding the metadata carried by
+// ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - message (string): The log message for non-critical output.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) PrintContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	l.log(ctx, 1, hqgologgerlevels.LevelSilent, message, ofs)
+}
+
+// ErrorContext logs a message at LevelError like Error, adding the metadata carried by
+// ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - message (string): The log message describing the error.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) ErrorContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	l.log(ctx, 1, hqgologgerlevels.LevelError, message, ofs)
+}
+
+// InfoContext logs a message at LevelInfo like Info, adding the metadata carried by
+// ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - message (string): The log message describing normal operation.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) InfoContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	l.log(ctx, 1, hqgologgerlevels.LevelInfo, message, ofs)
+}
+
+// WarnContext logs a message at LevelWarn like Warn, adding the metadata carried by
+// ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - message (string): The log message describing a potential issue.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) WarnContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	l.log(ctx, 1, hqgologgerlevels.LevelWarn, message, ofs)
+}
+
+// DebugContext logs a message at LevelDebug like Debug, adding the metadata carried by
+// ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - message (string): The log message for debugging purposes.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) DebugContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	l.log(ctx, 1, hqgologgerlevels.LevelDebug, message, ofs)
+}
+
+// TraceContext logs a message at LevelTrace like Trace, adding the metadata carried by
+// ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - message (string): The log message for tracing purposes.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) TraceContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	l.log(ctx, 1, hqgologgerlevels.LevelTrace, message, ofs)
+}
+
+// ContextWithLogger returns a copy of ctx that carries the given Logger, which can be
+// retrieved with LoggerFromContext. It is typically used to hand a logger scoped with
+// With down a call chain.
+//
+// A nil ctx is treated as context.Background().
+//
+// Parameters:
+//   - ctx (context.Context): The parent context.
+//   - logger (*Logger): The Logger to store.
+//
+// Returns:
+//   - derived (context.Context): The derived context.
+func ContextWithLogger(ctx context.Context, logger *Logger) (derived context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	derived = context.WithValue(ctx, _ContextKeyLogger, logger)
+
+	return
+}
+
+// LoggerFromContext returns the Logger stored in ctx by ContextWithLogger, or
+// DefaultLogger if ctx is nil or carries none.
+//
+// Parameters:
+//   - ctx (context.Context): The context to read from.
+//
+// Returns:
+//   - logger (*Logger): The stored Logger, or DefaultLogger.
+func LoggerFromContext(ctx context.Context) (logger *Logger) {
+	if ctx != nil {
+		logger, _ = ctx.Value(_ContextKeyLogger).(*Logger)
+	}
+
+	if logger == nil {
+		logger = DefaultLogger
+	}
+
+	return
+}
+
+// ContextWithFields returns a copy of ctx that carries the metadata set by the given
+// options, in addition to any metadata already carried by ctx; the new options take
+// precedence. The *Context logging methods and the SlogHandler add this metadata to
+// every event logged with the context, which makes it suitable for request-scoped
+// values such as a request ID or a scan ID. Only metadata is carried; options that set
+// the timestamp, level, or message have no effect. A nil ctx is treated as
+// context.Background().
+//
+// Parameters:
+//   - ctx (context.Context): The parent context.
+//   - ofs (...OptionFunc): Options setting the metadata to carry (e.g., WithString).
+//
+// Returns:
+//   - derived (context.Context): The derived context.
+func ContextWithFields(ctx context.Context, ofs ...OptionFunc) (derived context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	parent, _ := ctx.Value(_ContextKeyMetadata).(hqgologgerformatter.Fields)
+
+	event := &Event{
+		metadata: append(hqgologgerformatter.Fields(nil), parent...),
+	}
+
+	for _, f := range ofs {
+		f(event)
+	}
+
+	derived = context.WithValue(ctx, _ContextKeyMetadata, event.metadata)
+
+	return
+}
+
+// FieldsFromContext returns a copy of the metadata carried by ctx, as stored by
+// ContextWithFields, in the order it was set, or nil if ctx is nil or carries none.
+//
+// Parameters:
+//   - ctx (context.Context): The context to read from.
+//
+// Returns:
+//   - fields (hqgologgerformatter.Fields): A copy of the carried metadata.
+func FieldsFromContext(ctx context.Context) (fields hqgologgerformatter.Fields) {
+	if ctx == nil {
+		return
+	}
+
+	metadata, _ := ctx.Value(_ContextKeyMetadata).(hqgologgerformatter.Fields)
+	if metadata == nil {
+		return
+	}
+
+	fields = append(hqgologgerformatter.Fields(nil), metadata...)
+
+	return
+}
+
+// _AddContextMetadata adds the metadata carried by ctx to the event for keys the event
+// does not already set, placing it before the event's own metadata. A nil context
+// carries no metadata.
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying metadata.
+//   - event (*Event): The event to add the metadata to.
+func _AddContextMetadata(ctx context.Context, event *Event) {
+	if ctx == nil {
+		return
+	}
+
+	metadata, _ := ctx.Value(_ContextKeyMetadata).(hqgologgerformatter.Fields)
+
+	event.mergeMetadata(metadata)
+}
@@ -0,0 +1,29 @@
+package logger_test
+
+import (
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func TestContextNil(t *testing.T) {
+	t.Parallel()
+
+	//nolint:staticcheck // A nil context is deliberately passed.
+	ctx := hqgologger.ContextWithFields(nil, hqgologger.WithString("request_id", "42"))
+
+	fields := hqgologger.FieldsFromContext(ctx)
+
+	if value, ok := fields.Get("request_id"); !ok || value != "42" {
+		t.Errorf("FieldsFromContext() = %v, want request_id=42", fields)
+	}
+
+	logger := hqgologger.NewLogger()
+
+	//nolint:staticcheck // A nil context is deliberately passed.
+	ctx = hqgologger.ContextWithLogger(nil, logger)
+
+	if got := hqgologger.LoggerFromContext(ctx); got != logger {
+		t.Errorf("LoggerFromContext() = %p, want %p", got, logger)
+	}
+}
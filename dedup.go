@@ -0,0 +1,189 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// Deduplicator collapses runs of consecutive identical events, that is, events with the
+// same level, message, and metadata (including metadata bound with With, but not the
+// timestamp) logged by loggers of the same name, in the manner of syslog (see
+// Logger.SetDeduplicator). The first event of a run is logged, and the following ones
+// are counted instead. When the run ends, because a different event is logged, because
+// the flush interval passes, or because the logger is synced or closed, an event with
+// the message "last message repeated once" or "last message repeated N times" is logged
+// at the level of the run. LevelFatal events are never collapsed. A Deduplicator is
+// safe for concurrent use, and should be set on a single Logger.
+//
+// Fields:
+//   - cfg (*DeduplicatorConfiguration): Configuration settings for the deduplicator,
+//     holding its flush interval.
+//   - mutex (sync.Mutex): Serializes access to the run.
+//   - logger (*Logger): The logger the last event of the run was logged with, used to
+//     log the summary when the flush interval passes.
+//   - name (string): The name of the logger the last event was logged with.
+//   - level (hqgologgerlevels.Level): The level of the last event.
+//   - message (string): The message of the last event.
+//   - metadata (hqgologgerformatter.Fields): A copy of the metadata of the last event.
+//   - started (bool): True once an event has been logged.
+//   - repeated (uint64): The number of events collapsed since the last summary.
+//   - timer (*time.Timer): Logs the summary when the flush interval passes.
+type Deduplicator struct {
+	cfg      *DeduplicatorConfiguration
+	mutex    sync.Mutex
+	logger   *Logger
+	name     string
+	level    hqgologgerlevels.Level
+	message  string
+	metadata hqgologgerformatter.Fields
+	started  bool
+	repeated uint64
+	timer    *time.Timer
+}
+
+// deduplicate decides whether an event is logged or collapsed into the current run. If
+// the event ends a run with collapsed events, their number and the logger they were
+// logged with are returned so that the summary is logged with it before the event.
+//
+// Parameters:
+//   - logger (*Logger): The logger the event is logged with.
+//   - event (*Event): The event, with its bound metadata merged.
+//
+// Returns:
+//   - keep (bool): True if the event is logged.
+//   - previous (*Logger): The logger the last event of the run that ended was logged
+//     with.
+//   - level (hqgologgerlevels.Level): The level of the run that ended.
+//   - repeated (uint64): The number of events collapsed in the run that ended, or zero.
+func (d *Deduplicator) deduplicate(logger *Logger, event *Event) (keep bool, previous *Logger, level hqgologgerlevels.Level, repeated uint64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if event.level != hqgologgerlevels.LevelFatal && d.started && logger.name == d.name &&
+		event.level == d.level && event.message == d.message && event.metadata.Equal(d.metadata) {
+		d.repeated++
+		d.logger = logger
+
+		if d.repeated == 1 && d.cfg.FlushInterval > 0 {
+			d.timer = time.AfterFunc(d.cfg.FlushInterval, d.Flush)
+		}
+
+		return
+	}
+
+	keep = true
+	previous = d.logger
+	level, repeated = d.end()
+
+	d.logger, d.name = logger, logger.name
+	d.level, d.message, d.started = event.level, event.message, true
+	d.metadata = append(d.metadata[:0], event.metadata...)
+
+	return
+}
+
+// Flush logs the summary of the current run if events were collapsed since the last
+// one. Events identical to the last one logged keep being collapsed afterwards. It is
+// called when the flush interval passes and when the logger is synced or closed.
+func (d *Deduplicator) Flush() {
+	d.mutex.Lock()
+
+	logger := d.logger
+	level, repeated := d.end()
+
+	d.mutex.Unlock()
+
+	if repeated > 0 {
+		pipeline := logger.core.pipeline(logger.name)
+
+		logger.reportRepeated(&pipeline, level, repeated)
+	}
+}
+
+// end resets the count of collapsed events and stops the flush timer. The mutex must be
+// held.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The level of the run.
+//   - repeated (uint64): The number of events collapsed since the last summary.
+func (d *Deduplicator) end() (level hqgologgerlevels.Level, repeated uint64) {
+	level, repeated = d.level, d.repeated
+
+	d.repeated = 0
+
+	if d.timer != nil {
+		d.timer.Stop()
+
+		d.timer = nil
+	}
+
+	return
+}
+
+// reportRepeated logs the summary of a run of events collapsed by the deduplicator, as
+// "last message repeated once" for a single event and "last message repeated N times"
+// otherwise.
+//
+// Parameters:
+//   - pipeline (*_Pipeline): The configuration to log the summary with.
+//   - level (hqgologgerlevels.Level): The level of the run.
+//   - repeated (uint64): The number of events collapsed.
+func (l *Logger) reportRepeated(pipeline *_Pipeline, level hqgologgerlevels.Level, repeated uint64) {
+	message := "last message repeated once"
+
+	if repeated > 1 {
+		message = fmt.Sprintf("last message repeated %d times", repeated)
+	}
+
+	l.report(pipeline, level, message)
+}
+
+// DeduplicatorConfiguration defines configuration options for a Deduplicator.
+//
+// Fields:
+//   - FlushInterval (time.Duration): The time after the first collapsed event of a run
+//     at which the summary is logged even if the run has not ended. If zero or negative,
+//     summaries are only logged when a run ends or the logger is synced or closed.
+type DeduplicatorConfiguration struct {
+	FlushInterval time.Duration
+}
+
+// DefaultDeduplicatorConfig returns a default configuration for a Deduplicator. The
+// default settings log the summary of an ongoing run every 30 seconds.
+//
+// Returns:
+//   - cfg (*DeduplicatorConfiguration): A pointer to the default configuration.
+func DefaultDeduplicatorConfig() (cfg *DeduplicatorConfiguration) {
+	cfg = &DeduplicatorConfiguration{
+		FlushInterval: 30 * time.Second,
+	}
+
+	return
+}
+
+// NewDeduplicator creates and returns a new Deduplicator. If no configuration is
+// provided (i.e., cfg is nil), it uses the default configuration from
+// DefaultDeduplicatorConfig. The configuration must not be modified after the
+// deduplicator is created.
+//
+// Parameters:
+//   - cfg (*DeduplicatorConfiguration): The configuration for the deduplicator. If nil,
+//     defaults are applied.
+//
+// Returns:
+//   - deduplicator (*Deduplicator): A pointer to a new Deduplicator instance.
+func NewDeduplicator(cfg *DeduplicatorConfiguration) (deduplicator *Deduplicator) {
+	if cfg == nil {
+		cfg = DefaultDeduplicatorConfig()
+	}
+
+	deduplicator = &Deduplicator{
+		cfg: cfg,
+	}
+
+	return
+}
@@ -0,0 +1,151 @@
+package logger_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestDeduplicatorRuns(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetDeduplicator(hqgologger.NewDeduplicator(&hqgologger.DeduplicatorConfiguration{FlushInterval: 0}))
+
+	logger.Info("a")
+	logger.Info("a")
+	logger.Info("b")
+	logger.Info("b")
+	logger.Info("b")
+	logger.Info("b", hqgologger.WithInt("attempt", 2))
+	logger.Named("other").Info("b", hqgologger.WithInt("attempt", 2))
+
+	want := []string{
+		"a",
+		"last message repeated once",
+		"b",
+		"last message repeated 2 times",
+		"b",
+		"b",
+	}
+
+	if got := recorder.All().Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("logged %q, want %q", got, want)
+	}
+}
+
+func TestDeduplicatorFlush(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		flush func(logger *hqgologger.Logger)
+	}{
+		{
+			name: "sync",
+			flush: func(logger *hqgologger.Logger) {
+				_ = logger.Sync()
+			},
+		},
+		{
+			name: "close",
+			flush: func(logger *hqgologger.Logger) {
+				_ = logger.Close()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger, recorder := hqgologgerloggertest.New(t)
+
+			logger.SetDeduplicator(hqgologger.NewDeduplicator(&hqgologger.DeduplicatorConfiguration{FlushInterval: time.Hour}))
+
+			for range 4 {
+				logger.Warn("disk almost full")
+			}
+
+			test.flush(logger)
+
+			want := []string{"disk almost full", "last message repeated 3 times"}
+
+			if got := recorder.All().Messages(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("logged %q, want %q", got, want)
+			}
+
+			if entry := recorder.All()[1]; entry.Level != recorder.All()[0].Level {
+				t.Errorf("summary level = %v, want the level of the run", entry.Level)
+			}
+
+			// Flushing again reports nothing new.
+			test.flush(logger)
+
+			if got := recorder.Len(); got != len(want) {
+				t.Errorf("logged %d events after flushing again, want %d", got, len(want))
+			}
+		})
+	}
+}
+
+func TestDeduplicatorFlushInterval(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetDeduplicator(hqgologger.NewDeduplicator(&hqgologger.DeduplicatorConfiguration{FlushInterval: 10 * time.Millisecond}))
+
+	logger.Info("polling")
+	logger.Info("polling")
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for len(recorder.FilterMessage("last message repeated once")) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("no summary logged by the timer, got %q", recorder.All().Messages())
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	// The run goes on after the summary: identical events keep being collapsed.
+	logger.Info("polling")
+
+	if got := len(recorder.FilterMessage("polling")); got != 1 {
+		t.Errorf("logged %d \"polling\" events, want 1", got)
+	}
+}
+
+func TestDeduplicatorSummaryLogger(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetDeduplicator(hqgologger.NewDeduplicator(&hqgologger.DeduplicatorConfiguration{FlushInterval: 0}))
+
+	db := logger.Named("db").With(hqgologger.WithString("component", "pool"))
+
+	db.Warn("slow query")
+	db.Warn("slow query")
+	db.Warn("slow query")
+	logger.Named("api").Info("request served")
+
+	summaries := recorder.FilterMessage("last message repeated 2 times")
+
+	if len(summaries) != 1 {
+		t.Fatalf("logged %d summaries, want 1", len(summaries))
+	}
+
+	if got := summaries[0].Name; got != "db" {
+		t.Errorf("summary logged by %q, want \"db\"", got)
+	}
+
+	if value, _ := summaries[0].Value("component"); value != "pool" {
+		t.Errorf("summary component = %v, want \"pool\"", value)
+	}
+}
@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"context"
+
 	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
 	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
 	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
@@ -9,18 +11,18 @@ import (
 // DefaultLogger is a pre-configured Logger instance for convenient logging without
 // explicit instantiation. It is initialized in the init() function with the following
 // default configuration:
-//   - Level: LevelDebug (value 5), allowing all messages to be logged.
+//   - Level: LevelDebug (value 5), allowing all messages but LevelTrace ones to be logged.
 //   - Formatter: A Console formatter with colorized labels (Colorize: true), producing
 //     human-readable output in the format "[timestamp] [label] message [metadata]".
 //   - Writer: A Console writer directing LevelSilent messages to stdout and other levels
-//     (LevelFatal, LevelError, LevelInfo, LevelWarn, LevelDebug) to stderr, with newlines
+//     (LevelFatal, LevelError, LevelWarn, LevelInfo, LevelDebug, LevelTrace) to stderr, with newlines
 //     appended.
 //
-// Package-level functions (Fatal, Print, Error, Info, Warn, Debug) delegate to
+// Package-level functions (Fatal, Print, Error, Info, Warn, Debug, Trace) delegate to
 // DefaultLogger, enabling immediate logging with minimal setup. The Logger filters
 // messages based on its level threshold (lower values indicate higher severity, e.g.,
 // LevelFatal = 0), adds default labels if none are provided (e.g., "INF" for LevelInfo),
-// and exits the program with status code 1 for LevelFatal messages. Users can modify
+// and exits the program (status code 1 by default) for LevelFatal messages. Users can modify
 // DefaultLogger’s configuration (e.g., level, formatter, writer) to customize behavior
 // or create a new Logger instance for more control. The Logger is thread-safe for
 // configuration changes and relies on the formatter and writer for their own thread-safety.
@@ -37,14 +39,15 @@ func init() {
 // Fatal logs a message at LevelFatal using DefaultLogger, applying the provided options
 // (e.g., metadata, labels). The message is formatted and written if the logger’s threshold
 // allows (LevelFatal = 0, so it is always logged unless the formatter or writer is nil).
-// After writing, the program exits with status code 1, indicating a critical failure.
-// The method uses the options pattern for flexible configuration of the log event.
+// After writing, the writer is closed and the program exits with the configured
+// status code (1 by default), indicating a critical failure. The method uses the options
+// pattern for flexible configuration of the log event.
 //
 // Parameters:
 //   - message (string): The log message describing the critical failure.
 //   - ofs (...OptionFunc): Optional configurations for the log event (e.g., metadata, error).
 func Fatal(message string, ofs ...OptionFunc) {
-	DefaultLogger.Fatal(message, ofs...)
+	DefaultLogger.log(context.Background(), 1, hqgologgerlevels.LevelFatal, message, ofs)
 }
 
 // Print logs a message at LevelSilent using DefaultLogger, applying the provided options.
@@ -57,7 +60,7 @@ func Fatal(message string, ofs ...OptionFunc) {
 //   - message (string): The log message for non-critical output.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func Print(message string, ofs ...OptionFunc) {
-	DefaultLogger.Print(message, ofs...)
+	DefaultLogger.log(context.Background(), 1, hqgologgerlevels.LevelSilent, message, ofs)
 }
 
 // Error logs a message at LevelError using DefaultLogger, applying the provided options.
@@ -69,31 +72,31 @@ func Print(message string, ofs ...OptionFunc) {
 //   - message (string): The log message describing the error.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func Error(message string, ofs ...OptionFunc) {
-	DefaultLogger.Error(message, ofs...)
+	DefaultLogger.log(context.Background(), 1, hqgologgerlevels.LevelError, message, ofs)
 }
 
 // Info logs a message at LevelInfo using DefaultLogger, applying the provided options.
 // The message is formatted and written if the logger’s threshold allows (level <= LevelInfo).
-// LevelInfo (value 3) is used for informational messages about normal operation. The method
+// LevelInfo (value 4) is used for informational messages about normal operation. The method
 // uses the options pattern for flexible configuration.
 //
 // Parameters:
 //   - message (string): The log message describing normal operation.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func Info(message string, ofs ...OptionFunc) {
-	DefaultLogger.Info(message, ofs...)
+	DefaultLogger.log(context.Background(), 1, hqgologgerlevels.LevelInfo, message, ofs)
 }
 
 // Warn logs a message at LevelWarn using DefaultLogger, applying the provided options.
 // The message is formatted and written if the logger’s threshold allows (level <= LevelWarn).
-// LevelWarn (value 4) indicates potential issues that do not halt execution. The method
+// LevelWarn (value 3) indicates potential issues that do not halt execution. The method
 // uses the options pattern for flexible configuration.
 //
 // Parameters:
 //   - message (string): The log message describing a potential issue.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func Warn(message string, ofs ...OptionFunc) {
-	DefaultLogger.Warn(message, ofs...)
+	DefaultLogger.log(context.Background(), 1, hqgologgerlevels.LevelWarn, message, ofs)
 }
 
 // Debug logs a message at LevelDebug using DefaultLogger, applying the provided options.
@@ -105,5 +108,152 @@ func Warn(message string, ofs ...OptionFunc) {
 //   - message (string): The log message for debugging purposes.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func Debug(message string, ofs ...OptionFunc) {
-	DefaultLogger.Debug(message, ofs...)
+	DefaultLogger.log(context.Background(), 1, hqgologgerlevels.LevelDebug, message, ofs)
+}
+
+// Trace logs a message at LevelTrace using DefaultLogger, applying the provided options.
+// The message is formatted and written if the logger’s threshold allows (level <= LevelTrace).
+// LevelTrace (value 6) is used for the most verbose output, which DefaultLogger hides
+// unless its level is set to LevelTrace. The method uses the options pattern for flexible
+// configuration.
+//
+// Parameters:
+//   - message (string): The log message for tracing purposes.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func Trace(message string, ofs ...OptionFunc) {
+	DefaultLogger.log(context.Background(), 1, hqgologgerlevels.LevelTrace, message, ofs)
+}
+
+// Sync flushes any data buffered by DefaultLogger's writer. See Logger.Sync.
+//
+// Returns:
+//   - err (error): An error if flushing fails, otherwise nil.
+func Sync() (err error) {
+	err = DefaultLogger.Sync()
+
+	return
+}
+
+// Close closes DefaultLogger's writer. See Logger.Close.
+//
+// Returns:
+//   - err (error): An error if closing fails, otherwise nil.
+func Close() (err error) {
+	err = DefaultLogger.Close()
+
+	return
+}
+
+// With returns a logger derived from DefaultLogger that shares its level, formatter, and
+// writer, and adds the metadata set by the provided options to every event it logs.
+// Changes made to DefaultLogger's configuration apply to the derived logger as well.
+//
+// Parameters:
+//   - ofs (...OptionFunc): Options setting the metadata to bind (e.g., WithString).
+//
+// Returns:
+//   - logger (*Logger): A pointer to the derived Logger.
+func With(ofs ...OptionFunc) (logger *Logger) {
+	logger = DefaultLogger.With(ofs...)
+
+	return
+}
+
+// At returns an EventBuilder for an event at the given level logged with DefaultLogger,
+// or nil if the level is disabled. See Logger.At.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level of the event.
+//
+// Returns:
+//   - builder (*EventBuilder): A builder for the event, or nil if the level is disabled.
+func At(level hqgologgerlevels.Level) (builder *EventBuilder) {
+	builder = DefaultLogger.At(level)
+
+	return
+}
+
+// FatalContext logs a message at LevelFatal using the Logger carried by ctx (see
+// ContextWithLogger), or DefaultLogger if there is none, adding the metadata carried
+// by ctx (see ContextWithFields). After writing, the writer is closed and the program
+// exits with the configured status code (1 by default).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying the Logger and request-scoped metadata.
+//   - message (string): The log message describing the critical failure.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func FatalContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	LoggerFromContext(ctx).log(ctx, 1, hqgologgerlevels.LevelFatal, message, ofs)
+}
+
+// PrintContext logs a message at LevelSilent using the Logger carried by ctx (see
+// ContextWithLogger), or DefaultLogger if there is none, adding the metadata carried
+// by ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying the Logger and request-scoped metadata.
+//   - message (string): The log message for non-critical output.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func PrintContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	LoggerFromContext(ctx).log(ctx, 1, hqgologgerlevels.LevelSilent, message, ofs)
+}
+
+// ErrorContext logs a message at LevelError using the Logger carried by ctx (see
+// ContextWithLogger), or DefaultLogger if there is none, adding the metadata carried
+// by ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying the Logger and request-scoped metadata.
+//   - message (string): The log message describing the error.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func ErrorContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	LoggerFromContext(ctx).log(ctx, 1, hqgologgerlevels.LevelError, message, ofs)
+}
+
+// InfoContext logs a message at LevelInfo using the Logger carried by ctx (see
+// ContextWithLogger), or DefaultLogger if there is none, adding the metadata carried
+// by ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying the Logger and request-scoped metadata.
+//   - message (string): The log message describing normal operation.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func InfoContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	LoggerFromContext(ctx).log(ctx, 1, hqgologgerlevels.LevelInfo, message, ofs)
+}
+
+// WarnContext logs a message at LevelWarn using the Logger carried by ctx (see
+// ContextWithLogger), or DefaultLogger if there is none, adding the metadata carried
+// by ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying the Logger and request-scoped metadata.
+//   - message (string): The log message describing a potential issue.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func WarnContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	LoggerFromContext(ctx).log(ctx, 1, hqgologgerlevels.LevelWarn, message, ofs)
+}
+
+// DebugContext logs a message at LevelDebug using the Logger carried by ctx (see
+// ContextWithLogger), or DefaultLogger if there is none, adding the metadata carried
+// by ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying the Logger and request-scoped metadata.
+//   - message (string): The log message for debugging purposes.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func DebugContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	LoggerFromContext(ctx).log(ctx, 1, hqgologgerlevels.LevelDebug, message, ofs)
+}
+
+// TraceContext logs a message at LevelTrace using the Logger carried by ctx (see
+// ContextWithLogger), or DefaultLogger if there is none, adding the metadata carried
+// by ctx (see ContextWithFields).
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying the Logger and request-scoped metadata.
+//   - message (string): The log message for tracing purposes.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func TraceContext(ctx context.Context, message string, ofs ...OptionFunc) {
+	LoggerFromContext(ctx).log(ctx, 1, hqgologgerlevels.LevelTrace, message, ofs)
 }
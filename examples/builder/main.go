@@ -0,0 +1,22 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	logger := hqgologger.DefaultLogger
+
+	logger.AtInfo().Str("host", "example.com").Int("port", 443).Msg("Connected")
+
+	event := logger.AtError().Duration("elapsed", 1500*time.Millisecond)
+
+	if err := errors.New("connection reset"); err != nil {
+		event = event.Err(err)
+	}
+
+	event.Msg("Request failed")
+}
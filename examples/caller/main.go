@@ -0,0 +1,17 @@
+package main
+
+import (
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	hqgologger.DefaultLogger.SetCaller(true)
+
+	hqgologger.Info("Info message")
+
+	logf("Message logged through a wrapper")
+}
+
+func logf(message string) {
+	hqgologger.DefaultLogger.AddCallerSkip(1).Info(message)
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	ctx := hqgologger.ContextWithFields(context.Background(), hqgologger.WithString("scan_id", "scan-42"))
+
+	ctx = hqgologger.ContextWithLogger(ctx, hqgologger.With(hqgologger.WithString("component", "scanner")))
+
+	scan(ctx)
+}
+
+func scan(ctx context.Context) {
+	hqgologger.InfoContext(ctx, "Scan started")
+	hqgologger.WarnContext(ctx, "Target unreachable", hqgologger.WithString("target", "example.com"))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	hqgologger.DefaultLogger.SetDeduplicator(hqgologger.NewDeduplicator(&hqgologger.DeduplicatorConfiguration{
+		FlushInterval: 200 * time.Millisecond,
+	}))
+
+	defer hqgologger.Sync()
+
+	for range 37 {
+		hqgologger.Warn("Connection refused", hqgologger.WithString("host", "10.0.0.1"))
+	}
+
+	hqgologger.Info("Retrying with backup host")
+
+	for range 5 {
+		hqgologger.Warn("Connection refused", hqgologger.WithString("host", "10.0.0.2"))
+	}
+
+	// The flush interval passes while the run is ongoing.
+	time.Sleep(300 * time.Millisecond)
+
+	hqgologger.Warn("Connection refused", hqgologger.WithString("host", "10.0.0.2"))
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// WithRequest returns an option adding the method, path, and remote address of a request
+// to a log event.
+func WithRequest(r *http.Request) hqgologger.OptionFunc {
+	return func(event *hqgologger.Event) {
+		event.SetString("method", r.Method)
+		event.SetString("path", r.URL.Path)
+		event.SetString("remote", r.RemoteAddr)
+	}
+}
+
+func main() {
+	r := httptest.NewRequest(http.MethodGet, "/health", http.NoBody)
+
+	hqgologger.Info("Request received", WithRequest(r))
+
+	event := hqgologger.NewEvent(
+		hqgologger.WithLevel(hqgologgerlevels.LevelWarn),
+		hqgologger.WithMessage("Slow request"),
+		WithRequest(r),
+	)
+
+	if _, ok := event.Value("remote"); ok {
+		event.Delete("remote")
+	}
+
+	hqgologger.DefaultLogger.Log(event)
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"syscall"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+func main() {
+	wcfg := hqgologgerwriter.DefaultFileWriterConfig()
+
+	wcfg.Path = "logs/app.log"
+	wcfg.MaxSize = 10 * 1024 * 1024
+	wcfg.RotationInterval = 24 * time.Hour
+	wcfg.MaxBackups = 7
+	wcfg.Compress = true
+
+	writer, err := hqgologgerwriter.NewFileWriter(wcfg)
+	if err != nil {
+		hqgologger.Fatal("Failed to open log file", hqgologger.WithError(err))
+	}
+
+	defer writer.Close()
+
+	stop := writer.ReopenOn(syscall.SIGHUP)
+
+	defer stop()
+
+	logger := hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelDebug)
+	logger.SetFormatter(hqgologgerformatter.NewJSONFormatter(hqgologgerformatter.DefaultJSONConfig()))
+	logger.SetWriter(writer)
+
+	logger.Info("Info message", hqgologger.WithString("string-key", "string-value"))
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+func main() {
+	var output bytes.Buffer
+
+	cfg := hqgologgerwriter.DefaultConsoleWriterConfig()
+
+	cfg.Stdout = &output
+	cfg.Stderr = &output
+
+	logger := hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelDebug)
+	logger.SetFormatter(hqgologgerformatter.NewJSONFormatter(hqgologgerformatter.DefaultJSONConfig()))
+	logger.SetWriter(hqgologgerwriter.NewConsoleWriter(cfg))
+	logger.SetClock(hqgologgerloggertest.NewClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Second))
+
+	logger.Info("Scan started", hqgologger.WithString("target", "example.com"))
+	logger.Info("Scan finished", hqgologger.WithInt("findings", 3))
+
+	// In a test, compare against testdata with:
+	//
+	//	hqgologgerloggertest.AssertGolden(t, "testdata/scan.golden", output.Bytes())
+	fmt.Print(output.String())
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"sync/atomic"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+func main() {
+	var errors atomic.Int64
+
+	hostname, _ := os.Hostname()
+
+	hqgologger.DefaultLogger.AddHook(
+		// Enrich every event with the host name.
+		hqgologger.NewHook(&hqgologger.HookConfiguration{
+			BeforeFormat: func(event *hqgologger.Event) (keep bool) {
+				event.SetString("host", hostname)
+
+				return true
+			},
+		}),
+		// Drop health check noise and downgrade cache misses to debug.
+		hqgologger.NewHook(&hqgologger.HookConfiguration{
+			Levels: []hqgologgerlevels.Level{hqgologgerlevels.LevelInfo, hqgologgerlevels.LevelWarn},
+			BeforeFormat: func(event *hqgologger.Event) (keep bool) {
+				if strings.HasPrefix(event.Message(), "Cache miss") {
+					event.SetLevel(hqgologgerlevels.LevelDebug)
+					event.Delete("label")
+				}
+
+				return event.Message() != "Health check"
+			},
+		}),
+		// Count errors once they are written.
+		hqgologger.NewHook(&hqgologger.HookConfiguration{
+			Levels: []hqgologgerlevels.Level{hqgologgerlevels.LevelError},
+			AfterWrite: func(_ *hqgologger.Event, _ error) {
+				errors.Add(1)
+			},
+		}),
+	)
+
+	hqgologger.Info("Health check")
+	hqgologger.Info("Cache miss", hqgologger.WithString("key", "user:42"))
+	hqgologger.Error("Upstream unavailable")
+
+	hqgologger.Print("Errors logged", hqgologger.WithInt64("count", errors.Load()))
+}
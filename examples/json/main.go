@@ -0,0 +1,28 @@
+package main
+
+import (
+	hqgoerrors "github.com/hueristiq/hq-go-errors"
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+func main() {
+	logger := hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelDebug)
+
+	fcfg := hqgologgerformatter.DefaultJSONConfig()
+
+	fcfg.TimestampFormat = hqgologgerformatter.TimestampFormatUnixMilli
+	fcfg.MetadataKey = "metadata"
+
+	logger.SetFormatter(hqgologgerformatter.NewJSONFormatter(fcfg))
+	logger.SetWriter(hqgologgerwriter.NewConsoleWriter(hqgologgerwriter.DefaultConsoleWriterConfig()))
+
+	err := hqgoerrors.New("root error example!", hqgoerrors.WithType("ERROR_TYPE"), hqgoerrors.WithField("FIELD_KEY_1", "FIELD_VALUE_1"))
+
+	logger.Info("Info message", hqgologger.WithString("string-key", "string-value"), hqgologger.WithValue("value-key", 42))
+	logger.Error("Error message", hqgologger.WithError(err))
+}
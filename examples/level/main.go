@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"syscall"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+func main() {
+	level := hqgologger.NewAtomicLevel(hqgologgerlevels.LevelInfo)
+
+	// `kill -USR1 <pid>` switches to debug output, and back.
+	stop := level.ToggleDebugOn(syscall.SIGUSR1)
+
+	defer stop()
+
+	api := hqgologger.NewLogger()
+
+	api.SetAtomicLevel(level)
+	api.SetFormatter(hqgologgerformatter.NewConsoleFormatter(hqgologgerformatter.DefaultConsoleConfig()))
+	api.SetWriter(hqgologgerwriter.NewConsoleWriter(hqgologgerwriter.DefaultConsoleWriterConfig()))
+
+	db := hqgologger.NewLogger()
+
+	db.SetAtomicLevel(level)
+	db.SetFormatter(hqgologgerformatter.NewJSONFormatter(hqgologgerformatter.DefaultJSONConfig()))
+	db.SetWriter(hqgologgerwriter.NewConsoleWriter(hqgologgerwriter.DefaultConsoleWriterConfig()))
+
+	// curl localhost:8080/log/level
+	// curl -X PUT -d '{"level":"debug"}' localhost:8080/log/level
+	http.Handle("/log/level", level)
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		api.Debug("Request received", hqgologger.WithString("path", r.URL.Path))
+		db.Debug("Query executed", hqgologger.WithString("query", "SELECT 1"))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	api.Info("Listening", hqgologger.WithString("address", ":8080"))
+
+	if err := http.ListenAndServe(":8080", nil); err != nil {
+		api.Fatal("Server failed", hqgologger.WithError(err))
+	}
+}
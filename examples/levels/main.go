@@ -0,0 +1,23 @@
+package main
+
+import (
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+func main() {
+	// A level stored as an integer by an earlier version, in which 3 was info.
+	stored := 3
+
+	hqgologger.DefaultLogger.SetLevel(hqgologgerlevels.FromLegacyInt(stored))
+
+	hqgologger.Error("Shown", hqgologger.WithString("level", "error"))
+	hqgologger.Warn("Shown", hqgologger.WithString("level", "warn"))
+	hqgologger.Info("Shown", hqgologger.WithString("level", "info"))
+	hqgologger.Debug("Hidden", hqgologger.WithString("level", "debug"))
+	hqgologger.Trace("Hidden", hqgologger.WithString("level", "trace"))
+
+	hqgologger.DefaultLogger.SetLevel(hqgologgerlevels.LevelTrace)
+
+	hqgologger.Trace("Shown", hqgologger.WithString("level", "trace"))
+}
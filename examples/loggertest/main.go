@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func fetch(logger *hqgologger.Logger, url string) {
+	logger.Info("Fetching", hqgologger.WithString("url", url))
+	logger.Error("Fetch failed", hqgologger.WithString("url", url), hqgologger.WithError(errors.New("timeout")))
+}
+
+func main() {
+	recorder := hqgologgerloggertest.NewRecorder(nil)
+
+	logger := hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelDebug)
+	logger.AddSink(recorder.Sink())
+
+	fetch(logger, "https://example.com")
+
+	failures := recorder.FilterLevel(hqgologgerlevels.LevelError).FilterField("url", "https://example.com")
+
+	fmt.Println(len(failures), failures.Messages())
+
+	entries := recorder.TakeAll()
+
+	fmt.Println(len(entries), recorder.Len())
+}
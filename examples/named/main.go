@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+func main() {
+	// LOG_LEVEL="http=debug,http.client=warn,*=info" go run ./examples/named
+	rules, err := hqgologger.ParseLevelRules(os.Getenv("LOG_LEVEL"))
+	if err != nil {
+		hqgologger.Fatal("Invalid LOG_LEVEL", hqgologger.WithError(err))
+	}
+
+	// go run ./examples/named -log-level "http=debug,http.client=warn,*=info"
+	flag.Var(rules, "log-level", "per-component levels, e.g. \"http=debug,*=info\"")
+	flag.Parse()
+
+	hqgologger.DefaultLogger.SetLevel(hqgologgerlevels.LevelInfo)
+	hqgologger.DefaultLogger.SetLevelRules(rules)
+
+	http := hqgologger.DefaultLogger.Named("http")
+	client := http.Named("client")
+	db := hqgologger.DefaultLogger.Named("db")
+
+	http.Debug("Request received", hqgologger.WithString("path", "/users"))
+	client.Debug("Dialing upstream", hqgologger.WithString("host", "api.example.com"))
+	client.Warn("Upstream slow", hqgologger.WithInt("latency_ms", 1200))
+	db.Debug("Query executed", hqgologger.WithString("query", "SELECT 1"))
+	db.Info("Connection pool ready", hqgologger.WithInt("size", 10))
+}
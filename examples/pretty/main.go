@@ -0,0 +1,27 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+)
+
+func main() {
+	fcfg := hqgologgerformatter.DefaultConsoleConfig()
+
+	fcfg.PrettyPrint = true
+
+	hqgologger.DefaultLogger.SetFormatter(hqgologgerformatter.NewConsoleFormatter(fcfg))
+
+	request := map[string]any{
+		"method":  "GET",
+		"path":    "/search",
+		"headers": map[string][]string{"Accept": {"application/json"}},
+	}
+
+	err := fmt.Errorf("request failed: %w", errors.Join(errors.New("connection refused"), errors.New("retries exhausted")))
+
+	hqgologger.Error("Request failed\nafter 3 attempts", hqgologger.WithString("component", "client"), hqgologger.WithValue("request", request), hqgologger.WithError(err))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	sampler := hqgologger.NewSampler(&hqgologger.SamplerConfiguration{
+		Window:     100 * time.Millisecond,
+		First:      3,
+		Thereafter: 1000,
+		Summary:    true,
+	})
+
+	hqgologger.DefaultLogger.SetSampler(sampler)
+
+	for i := range 5000 {
+		hqgologger.Warn("Rate limited, retrying", hqgologger.WithInt("attempt", i))
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	// The first event of the next window reports the events dropped in the previous one.
+	hqgologger.Warn("Rate limited, retrying", hqgologger.WithInt("attempt", 5000))
+
+	hqgologger.Print("Sampling done", hqgologger.WithValue("dropped", sampler.Dropped()))
+}
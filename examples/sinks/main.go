@@ -0,0 +1,40 @@
+package main
+
+import (
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+func main() {
+	wcfg := hqgologgerwriter.DefaultFileWriterConfig()
+
+	wcfg.Path = "logs/app.json"
+
+	file, err := hqgologgerwriter.NewFileWriter(wcfg)
+	if err != nil {
+		hqgologger.Fatal("Failed to open log file", hqgologger.WithError(err))
+	}
+
+	logger := hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelDebug)
+	logger.AddSink(
+		hqgologger.NewSink(&hqgologger.SinkConfiguration{
+			Formatter: hqgologgerformatter.NewConsoleFormatter(hqgologgerformatter.DefaultConsoleConfig()),
+			Writer:    hqgologgerwriter.NewConsoleWriter(hqgologgerwriter.DefaultConsoleWriterConfig()),
+			Level:     hqgologgerlevels.LevelInfo,
+		}),
+		hqgologger.NewSink(&hqgologger.SinkConfiguration{
+			Formatter: hqgologgerformatter.NewJSONFormatter(hqgologgerformatter.DefaultJSONConfig()),
+			Writer:    file,
+			Level:     hqgologgerlevels.LevelDebug,
+		}),
+	)
+
+	defer logger.Close()
+
+	logger.Info("Written to the terminal and the file", hqgologger.WithString("string-key", "string-value"))
+	logger.Debug("Written to the file only")
+}
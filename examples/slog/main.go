@@ -0,0 +1,15 @@
+package main
+
+import (
+	"log/slog"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	logger := slog.New(hqgologger.NewSlogHandler(hqgologger.DefaultLogger, nil))
+
+	logger.Info("Info message", "string-key", "string-value", "value-key", 42)
+	logger.WithGroup("request").With("method", "GET").Warn("Warn message", slog.Int("status", 503))
+	logger.Debug("Debug message", slog.Group("user", slog.String("id", "12345")))
+}
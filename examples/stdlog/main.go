@@ -0,0 +1,19 @@
+package main
+
+import (
+	"log"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	restore := hqgologger.RedirectStdLog(hqgologger.DefaultLogger, nil)
+
+	defer restore()
+
+	log.Printf("Info message from %s", "log.Printf")
+	log.Print("[WARN] Warn message")
+	log.Print("ERROR: Error message")
+	log.Print("error without a marker stays info")
+	log.Print("[debug]Debug message")
+}
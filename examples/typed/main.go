@@ -0,0 +1,24 @@
+package main
+
+import (
+	"net"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	started := time.Now()
+
+	hqgologger.Info("Request served",
+		hqgologger.WithString("method", "GET"),
+		hqgologger.WithInt("status", 200),
+		hqgologger.WithInt64("bytes", 5120),
+		hqgologger.WithFloat64("ratio", 0.75),
+		hqgologger.WithBool("cached", true),
+		hqgologger.WithDuration("elapsed", time.Since(started)),
+		hqgologger.WithTime("started", started),
+		hqgologger.WithStringer("remote", net.IPv4(127, 0, 0, 1)),
+		hqgologger.WithBytes("body", []byte("ok")),
+	)
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+func main() {
+	scanner := hqgologger.With(hqgologger.WithString("component", "scanner"))
+
+	target := scanner.With(hqgologger.WithString("target", "example.com"))
+
+	scanner.Info("Scanner started")
+	target.Info("Scanning target", hqgologger.WithValue("ports", 1024))
+	target.Warn("Target overrides component", hqgologger.WithString("component", "resolver"))
+}
@@ -0,0 +1,216 @@
+package logger_test
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+// exitTrace records, in order, the steps taken by a logger on a fatal event.
+type exitTrace struct {
+	mutex *sync.Mutex
+	steps []string
+}
+
+func (e *exitTrace) add(step string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.steps = append(e.steps, step)
+}
+
+func (e *exitTrace) all() (steps []string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	steps = append(steps, e.steps...)
+
+	return
+}
+
+// traceWriter is a Writer recording its writes and its closing in an exitTrace.
+type traceWriter struct {
+	name  string
+	trace *exitTrace
+}
+
+func (w *traceWriter) Write(data []byte, _ hqgologgerlevels.Level) (err error) {
+	w.trace.add(w.name + " write " + string(data))
+
+	return
+}
+
+func (w *traceWriter) Close() (err error) {
+	w.trace.add(w.name + " close")
+
+	return
+}
+
+// newExitLogger returns a logger writing messages to a traceWriter, with no timestamp
+// or label, and recording its exits in trace.
+func newExitLogger(trace *exitTrace) (logger *hqgologger.Logger) {
+	cfg := hqgologgerformatter.DefaultLogfmtConfig()
+
+	cfg.TimestampKey = ""
+	cfg.LevelKey = ""
+
+	logger = hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelInfo)
+	logger.SetFormatter(hqgologgerformatter.NewLogfmtFormatter(cfg))
+	logger.SetWriter(&traceWriter{name: "writer", trace: trace})
+	logger.SetExitFunc(func(code int) {
+		trace.add("exit " + strconv.Itoa(code))
+	})
+
+	return
+}
+
+func TestFatalExitCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		setup func(logger *hqgologger.Logger)
+		log   func(logger *hqgologger.Logger)
+		want  string
+	}{
+		{
+			name:  "default",
+			setup: func(*hqgologger.Logger) {},
+			log: func(logger *hqgologger.Logger) {
+				logger.Fatal("failed")
+			},
+			want: "exit 1",
+		},
+		{
+			name: "logger code",
+			setup: func(logger *hqgologger.Logger) {
+				logger.SetExitCode(3)
+			},
+			log: func(logger *hqgologger.Logger) {
+				logger.Fatal("failed")
+			},
+			want: "exit 3",
+		},
+		{
+			name: "event code",
+			setup: func(logger *hqgologger.Logger) {
+				logger.SetExitCode(3)
+			},
+			log: func(logger *hqgologger.Logger) {
+				logger.Fatal("failed", hqgologger.WithExitCode(7))
+			},
+			want: "exit 7",
+		},
+		{
+			name:  "builder code",
+			setup: func(*hqgologger.Logger) {},
+			log: func(logger *hqgologger.Logger) {
+				logger.AtFatal().ExitCode(9).Msg("failed")
+			},
+			want: "exit 9",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			trace := &exitTrace{mutex: &sync.Mutex{}}
+
+			logger := newExitLogger(trace)
+
+			test.setup(logger)
+			test.log(logger)
+
+			steps := trace.all()
+
+			if len(steps) == 0 || steps[len(steps)-1] != test.want {
+				t.Errorf("steps = %q, want them to end with %q", steps, test.want)
+			}
+		})
+	}
+}
+
+func TestFatalRunsHooksThenClosesWritersThenExits(t *testing.T) {
+	t.Parallel()
+
+	trace := &exitTrace{mutex: &sync.Mutex{}}
+
+	logger := newExitLogger(trace)
+
+	logger.AddSink(hqgologger.NewSink(&hqgologger.SinkConfiguration{
+		Formatter: hqgologgerformatter.NewLogfmtFormatter(&hqgologgerformatter.LogfmtFormatterConfiguration{MessageKey: "msg"}),
+		Writer:    &traceWriter{name: "sink", trace: trace},
+		Level:     hqgologgerlevels.LevelTrace,
+	}))
+
+	logger.AddExitHook(func() {
+		trace.add("hook 1")
+
+		logger.Info("from hook")
+	})
+	logger.AddExitHook(func() {
+		trace.add("hook 2")
+	})
+
+	logger.Info("before")
+	logger.Debug("filtered")
+	logger.Fatal("failed")
+
+	want := []string{
+		"writer write msg=before",
+		"sink write msg=before",
+		"writer write msg=failed",
+		"sink write msg=failed",
+		"hook 1",
+		"writer write msg=\"from hook\"",
+		"sink write msg=\"from hook\"",
+		"hook 2",
+		"writer close",
+		"sink close",
+		"exit 1",
+	}
+
+	if got := trace.all(); !reflect.DeepEqual(got, want) {
+		t.Errorf("steps =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestFatalDrainsAsyncWriter(t *testing.T) {
+	t.Parallel()
+
+	trace := &exitTrace{mutex: &sync.Mutex{}}
+
+	logger := newExitLogger(trace)
+
+	cfg := hqgologgerwriter.DefaultAsyncWriterConfig()
+
+	cfg.CloseTimeout = 5 * time.Second
+
+	logger.SetWriter(hqgologgerwriter.NewAsyncWriter(&traceWriter{name: "writer", trace: trace}, cfg))
+
+	for range 100 {
+		logger.Info("queued")
+	}
+
+	logger.Fatal("failed")
+
+	steps := trace.all()
+
+	if len(steps) != 103 {
+		t.Fatalf("got %d steps, want 100 queued writes, the fatal write, closing, and exiting: %q", len(steps), steps)
+	}
+
+	if got, want := steps[100:], []string{"writer write msg=failed", "writer close", "exit 1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("last steps = %q, want %q", got, want)
+	}
+}
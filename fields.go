@@ -0,0 +1,155 @@
+package logger
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"unsafe"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+)
+
+// WithInt returns an OptionFunc that adds an int to a log event's metadata. Unlike
+// WithValue, the value is stored in a typed field without being boxed in an interface,
+// avoiding an allocation.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (int): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with an int value.
+func WithInt(key string, value int) OptionFunc {
+	return WithInt64(key, int64(value))
+}
+
+// WithInt64 returns an OptionFunc that adds an int64 to a log event's metadata, stored
+// in a typed field without boxing.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (int64): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with an int64 value.
+func WithInt64(key string, value int64) OptionFunc {
+	return func(event *Event) {
+		event.SetField(hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindInt64, Integer: value})
+	}
+}
+
+// WithFloat64 returns an OptionFunc that adds a float64 to a log event's metadata,
+// stored in a typed field without boxing.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (float64): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with a float64 value.
+func WithFloat64(key string, value float64) OptionFunc {
+	return func(event *Event) {
+		event.SetField(hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindFloat64, Integer: int64(math.Float64bits(value))})
+	}
+}
+
+// WithBool returns an OptionFunc that adds a bool to a log event's metadata, stored in
+// a typed field without boxing.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (bool): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with a bool value.
+func WithBool(key string, value bool) OptionFunc {
+	return func(event *Event) {
+		field := hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindBool}
+
+		if value {
+			field.Integer = 1
+		}
+
+		event.SetField(field)
+	}
+}
+
+// WithDuration returns an OptionFunc that adds a time.Duration to a log event's
+// metadata, stored in a typed field without boxing. Formatters write it in its
+// time.Duration.String form (e.g., "1.5s").
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (time.Duration): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with a duration value.
+func WithDuration(key string, value time.Duration) OptionFunc {
+	return func(event *Event) {
+		event.SetField(hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindDuration, Integer: int64(value)})
+	}
+}
+
+// WithTime returns an OptionFunc that adds a time.Time to a log event's metadata,
+// stored in a typed field as Unix nanoseconds and its location, without boxing.
+// Formatters write it in RFC3339Nano. Times outside the range of Unix nanoseconds
+// (years 1678 to 2262) are stored like WithValue instead.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (time.Time): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with a time value.
+func WithTime(key string, value time.Time) OptionFunc {
+	return func(event *Event) {
+		if value.Before(_MinUnixNanoTime) || value.After(_MaxUnixNanoTime) {
+			event.SetValue(key, value)
+
+			return
+		}
+
+		event.SetField(hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindTime, Integer: value.UnixNano(), Value: value.Location()})
+	}
+}
+
+// WithStringer returns an OptionFunc that adds a fmt.Stringer to a log event's metadata.
+// Its String method is called only when the event is formatted, so the conversion is
+// skipped for events that are discarded.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (fmt.Stringer): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with a stringer value.
+func WithStringer(key string, value fmt.Stringer) OptionFunc {
+	return func(event *Event) {
+		event.SetField(hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindStringer, Value: value})
+	}
+}
+
+// WithBytes returns an OptionFunc that adds a byte slice holding text (e.g., a response
+// body) to a log event's metadata, stored in a typed field without boxing. Formatters
+// write it as a string. The slice is neither copied nor boxed, so it must not be
+// modified while the event is being logged, or, if bound with With, for the lifetime
+// of the logger.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value ([]byte): The metadata value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with a bytes value.
+func WithBytes(key string, value []byte) OptionFunc {
+	return func(event *Event) {
+		event.SetField(hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindBytes, String: unsafe.String(unsafe.SliceData(value), len(value))})
+	}
+}
+
+var (
+	// _MinUnixNanoTime is the earliest time representable in Unix nanoseconds.
+	_MinUnixNanoTime = time.Unix(0, math.MinInt64)
+	// _MaxUnixNanoTime is the latest time representable in Unix nanoseconds.
+	_MaxUnixNanoTime = time.Unix(0, math.MaxInt64)
+)
@@ -23,10 +23,11 @@ type AuroraColorizer struct {
 // Colorize applies color and style formatting to the input text based on the provided
 // log level, using the aurora package. Each severity level is mapped to a specific
 // color and bold style for clarity: LevelFatal and LevelError are bright red,
-// LevelInfo is bright blue, LevelWarn is bright yellow, LevelDebug is bright magenta,
-// and LevelSilent or invalid levels return the text unchanged. The method satisfies
-// the formatter.Colorizer interface and is intended for use with console formatters
-// to enhance log output readability in terminal environments.
+// LevelWarn is bright yellow, LevelInfo is bright blue, LevelDebug is bright magenta,
+// LevelTrace is bright cyan, and LevelSilent or invalid levels return the text
+// unchanged. The method satisfies the formatter.Colorizer interface and is intended for
+// use with console formatters to enhance log output readability in terminal
+// environments.
 //
 // Parameters:
 //   - text (string): The input text to colorize, typically a log label (e.g., "INF").
@@ -44,12 +45,14 @@ func (c *AuroraColorizer) Colorize(text string, level hqgologgerlevels.Level) (c
 		colorized = c.au.BrightRed(text).Bold().String()
 	case hqgologgerlevels.LevelError:
 		colorized = c.au.BrightRed(text).Bold().String()
-	case hqgologgerlevels.LevelInfo:
-		colorized = c.au.BrightBlue(text).Bold().String()
 	case hqgologgerlevels.LevelWarn:
 		colorized = c.au.BrightYellow(text).Bold().String()
+	case hqgologgerlevels.LevelInfo:
+		colorized = c.au.BrightBlue(text).Bold().String()
 	case hqgologgerlevels.LevelDebug:
 		colorized = c.au.BrightMagenta(text).Bold().String()
+	case hqgologgerlevels.LevelTrace:
+		colorized = c.au.BrightCyan(text).Bold().String()
 	}
 
 	return
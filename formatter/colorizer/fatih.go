@@ -19,28 +19,31 @@ import (
 //     using high-intensity red with bold styling.
 //   - err (*color.Color): The color configuration for LevelError messages,
 //     using high-intensity red with bold styling.
-//   - info (*color.Color): The color configuration for LevelInfo messages,
-//     using high-intensity blue with bold styling.
 //   - warn (*color.Color): The color configuration for LevelWarn messages,
 //     using high-intensity yellow with bold styling.
+//   - info (*color.Color): The color configuration for LevelInfo messages,
+//     using high-intensity blue with bold styling.
 //   - debug (*color.Color): The color configuration for LevelDebug messages,
 //     using high-intensity magenta with bold styling.
+//   - trace (*color.Color): The color configuration for LevelTrace messages,
+//     using high-intensity cyan with bold styling.
 type FatihColorizer struct {
 	fatal *color.Color
 	err   *color.Color
-	info  *color.Color
 	warn  *color.Color
+	info  *color.Color
 	debug *color.Color
+	trace *color.Color
 }
 
 // Colorize applies color and style formatting to the input text based on the
 // provided log level, using the fatih/color package. Each severity level is mapped
 // to a specific color and bold style for clarity: LevelFatal and LevelError are
-// high-intensity red, LevelInfo is high-intensity blue, LevelWarn is high-intensity
-// yellow, LevelDebug is high-intensity magenta, and LevelSilent or invalid levels
-// return the text unchanged. The method satisfies the formatter.Colorizer interface
-// and is intended for use with console formatters to enhance log output readability
-// in terminal environments.
+// high-intensity red, LevelWarn is high-intensity yellow, LevelInfo is high-intensity
+// blue, LevelDebug is high-intensity magenta, LevelTrace is high-intensity cyan, and
+// LevelSilent or invalid levels return the text unchanged. The method satisfies the
+// formatter.Colorizer interface and is intended for use with console formatters to
+// enhance log output readability in terminal environments.
 //
 // Parameters:
 //   - text (string): The input text to colorize, typically a log label (e.g., "INF").
@@ -58,12 +61,14 @@ func (fc *FatihColorizer) Colorize(text string, level hqgologgerlevels.Level) (c
 		colorized = fc.fatal.Sprint(text)
 	case hqgologgerlevels.LevelError:
 		colorized = fc.err.Sprint(text)
-	case hqgologgerlevels.LevelInfo:
-		colorized = fc.info.Sprint(text)
 	case hqgologgerlevels.LevelWarn:
 		colorized = fc.warn.Sprint(text)
+	case hqgologgerlevels.LevelInfo:
+		colorized = fc.info.Sprint(text)
 	case hqgologgerlevels.LevelDebug:
 		colorized = fc.debug.Sprint(text)
+	case hqgologgerlevels.LevelTrace:
+		colorized = fc.trace.Sprint(text)
 	}
 
 	return
@@ -74,8 +79,8 @@ var _ hqgologgerformatter.Colorizer = (*FatihColorizer)(nil)
 // NewFatihColorizer creates and returns a new FatihColorizer instance, initialized
 // with color configurations for each log level using the fatih/color package. Each
 // level is assigned a high-intensity color with bold styling: red for LevelFatal
-// and LevelError, blue for LevelInfo, yellow for LevelWarn, and magenta for
-// LevelDebug. This factory function provides a convenient way to instantiate a
+// and LevelError, yellow for LevelWarn, blue for LevelInfo, magenta for LevelDebug,
+// and cyan for LevelTrace. This factory function provides a convenient way to instantiate a
 // FatihColorizer for use in logging systems that require colorized console output,
 // such as with a Console formatter configured for colorization.
 //
@@ -86,9 +91,10 @@ func NewFatihColorizer() (colorizer *FatihColorizer) {
 	colorizer = &FatihColorizer{
 		fatal: color.New(color.FgHiRed, color.Bold),
 		err:   color.New(color.FgHiRed, color.Bold),
-		info:  color.New(color.FgHiBlue, color.Bold),
 		warn:  color.New(color.FgHiYellow, color.Bold),
+		info:  color.New(color.FgHiBlue, color.Bold),
 		debug: color.New(color.FgHiMagenta, color.Bold),
+		trace: color.New(color.FgHiCyan, color.Bold),
 	}
 
 	return
@@ -3,6 +3,8 @@ package formatter
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,12 +28,16 @@ type Console struct {
 }
 
 // Format converts a Log struct into a formatted byte slice for console output.
-// The output format is "[timestamp] [label] message [metadata]" (with optional components).
+// The output format is "[timestamp] [label] [caller] [name:] message [metadata]"
+// (with optional components).
 // Timestamps are included if configured, using the specified format (default: RFC3339).
 // Labels are extracted from metadata and colorized if enabled. The message is trimmed
-// of trailing newlines. Metadata is appended as key=value pairs, with special handling
+// of trailing newlines. Metadata is appended as key=value pairs in the order it was set
+// (or sorted by key if SortKeys is enabled), with special handling
 // for errors to include stack traces for hqgoerrors.Error types or plain error messages
-// otherwise. The buffer is pre-allocated with an estimated size for efficiency.
+// otherwise. If PrettyPrint is enabled, the output spans multiple lines instead, as
+// described for writePretty. The buffer is pre-allocated with an estimated size for
+// efficiency.
 //
 // Parameters:
 //   - log (*Log): The log message to format, containing context, timestamp, level,
@@ -41,19 +47,38 @@ type Console struct {
 //   - data ([]byte): The formatted log message as a byte slice, ready for console output.
 //   - err (error): An error if the log level is invalid, otherwise nil.
 func (c *Console) Format(log *Log) (data []byte, err error) {
-	if !log.Level.IsValid() {
-		err = fmt.Errorf("invalid log level: %w", err)
+	buffer := &bytes.Buffer{}
 
+	if err = c.FormatTo(buffer, log); err != nil {
 		return
 	}
 
-	metadata := make(map[string]interface{})
+	data = buffer.Bytes()
+
+	return
+}
+
+// FormatTo writes the formatted log message to the given buffer, as described for
+// Format, allowing the caller to reuse buffers across messages.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write the formatted log message to.
+//   - log (*Log): The log message to format.
+//
+// Returns:
+//   - err (error): An error if the log level is invalid, otherwise nil.
+func (c *Console) FormatTo(buffer *bytes.Buffer, log *Log) (err error) {
+	if !log.Level.IsValid() {
+		err = fmt.Errorf("%w: %d", ErrInvalidLevel, log.Level)
 
-	for k, v := range log.Metadata {
-		metadata[k] = v
+		return
 	}
 
-	buffer := &bytes.Buffer{}
+	metadata := log.Metadata
+
+	if c.cfg.SortKeys {
+		metadata = metadata.Sorted()
+	}
 
 	estimatedSize := len(log.Message) + 50
 
@@ -68,48 +93,67 @@ func (c *Console) Format(log *Log) (data []byte, err error) {
 	buffer.Grow(estimatedSize)
 
 	if c.cfg.IncludeTimestamp && !log.Timestamp.IsZero() {
-		buffer.WriteString(log.Timestamp.Format(c.cfg.TimestampFormat))
+		buffer.Write(log.Timestamp.AppendFormat(buffer.AvailableBuffer(), c.cfg.TimestampFormat))
 		buffer.WriteByte(' ')
 	}
 
-	if label, ok := metadata["label"]; ok {
-		if label != "" && c.cfg.IncludeLabel {
-			if str, ok := label.(string); ok && str != "" {
-				colorized := str
+	if label, ok := metadata.Lookup("label"); ok && c.cfg.IncludeLabel {
+		str := label.String
 
-				if c.cfg.Colorize {
-					colorized = c.cfg.Colorizer.Colorize(str, log.Level)
-				}
+		if label.Kind == FieldKindAny {
+			str, _ = label.Value.(string)
+		}
+
+		if str != "" {
+			colorized := str
 
-				buffer.WriteByte('[')
-				buffer.WriteString(colorized)
-				buffer.WriteByte(']')
-				buffer.WriteByte(' ')
+			if c.cfg.Colorize {
+				colorized = c.cfg.Colorizer.Colorize(str, log.Level)
 			}
+
+			buffer.WriteByte('[')
+			buffer.WriteString(colorized)
+			buffer.WriteByte(']')
+			buffer.WriteByte(' ')
 		}
+	}
 
-		delete(metadata, "label")
+	if c.cfg.IncludeCaller && log.Caller != nil {
+		buffer.WriteString(log.Caller.String())
+		buffer.WriteByte(' ')
+	}
+
+	if c.cfg.IncludeName && log.Name != "" {
+		buffer.WriteString(log.Name)
+		buffer.WriteString(": ")
 	}
 
 	message := strings.TrimSuffix(log.Message, "\n")
 
+	if c.cfg.PrettyPrint {
+		c.writePretty(buffer, message, metadata)
+
+		return
+	}
+
 	buffer.WriteString(message)
 
-	for k, v := range metadata {
-		if k == "" || v == nil {
+	for _, field := range metadata {
+		if field.Key == "" || field.Key == "label" || field.IsNil() {
 			continue
 		}
 
 		buffer.WriteByte(' ')
-		buffer.WriteString(k)
+		buffer.WriteString(field.Key)
 		buffer.WriteByte('=')
-
-		fmt.Fprintf(buffer, "%v", v)
+		buffer.Write(field.AppendText(buffer.AvailableBuffer()))
 	}
 
 	var formattedErrorMetadata string
 
-	if errValue, ok := metadata["error"]; ok && errValue != nil {
+	if errField, ok := metadata.Lookup("error"); ok && !errField.IsNil() {
+		errValue := errField.Any()
+
 		if err, ok := errValue.(error); ok {
 			var hqErr hqgoerrors.Error
 
@@ -121,17 +165,344 @@ func (c *Console) Format(log *Log) (data []byte, err error) {
 		} else {
 			formattedErrorMetadata = fmt.Sprintf("\n\n%v", errValue)
 		}
-
-		delete(metadata, "error")
 	}
 
 	buffer.WriteString(formattedErrorMetadata)
 
-	data = buffer.Bytes()
+	return
+}
+
+// writePretty writes the message and metadata in the multi-line PrettyPrint layout.
+// Continuation lines of a multi-line message are indented, and metadata follows as an
+// indented block with one key per line and values aligned in a column. Maps, slices,
+// arrays, and structs are expanded into nested blocks, and errors are rendered as a tree
+// of their wrapped errors, followed by the stack trace for hqgoerrors.Error values.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - message (string): The log message, trimmed of trailing newlines.
+//   - metadata (Fields): The metadata to write.
+func (c *Console) writePretty(buffer *bytes.Buffer, message string, metadata Fields) {
+	lines := strings.Split(message, "\n")
+
+	buffer.WriteString(lines[0])
+
+	for _, line := range lines[1:] {
+		buffer.WriteByte('\n')
+		buffer.WriteString(prettyIndent)
+		buffer.WriteString(line)
+	}
+
+	fields := make(Fields, 0, len(metadata))
+
+	for _, field := range metadata {
+		if field.Key == "" || field.Key == "label" || field.IsNil() {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	c.writePrettyFields(buffer, fields, 1, nil)
+
+	if errField, ok := metadata.Lookup("error"); ok {
+		if err, ok := errField.Value.(error); ok {
+			var hqErr hqgoerrors.Error
+
+			if hqgoerrors.As(err, &hqErr) {
+				buffer.WriteString("\n\n")
+				buffer.WriteString(hqgoerrors.ToString(err, hqgoerrors.FormatWithTrace()))
+			}
+		}
+	}
+}
+
+// writePrettyFields writes each field on its own line at the given depth, with the
+// values aligned after the longest key.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - fields (Fields): The fields to write.
+//   - depth (int): The indentation depth of the keys.
+//   - visited ([]uintptr): The addresses of the pointers, maps, and slices being
+//     expanded, from the outermost value to the fields' parent.
+func (c *Console) writePrettyFields(buffer *bytes.Buffer, fields Fields, depth int, visited []uintptr) {
+	width := 0
+
+	for _, field := range fields {
+		width = max(width, len(field.Key))
+	}
+
+	for _, field := range fields {
+		buffer.WriteByte('\n')
+		buffer.WriteString(strings.Repeat(prettyIndent, depth))
+		buffer.WriteString(field.Key)
+		buffer.WriteByte(':')
+
+		if field.Kind != FieldKindAny {
+			c.writePrettyScalar(buffer, string(field.AppendText(nil)), depth, width-len(field.Key)+1)
+
+			continue
+		}
+
+		c.writePrettyValue(buffer, field.Value, depth, width-len(field.Key)+1, visited)
+	}
+}
+
+// writePrettyValue writes a value after its key. Scalars are written on the key's line,
+// padded to the value column, while non-empty maps, slices, arrays, and structs are
+// expanded on the following lines one level deeper. Errors and fmt.Stringer values
+// are never expanded. A pointer, map, or slice that is already being expanded is
+// written as prettyCycle, and values nested deeper than prettyMaxDepth as
+// prettyElided, so cyclic values are never walked endlessly.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - value (interface{}): The value to write.
+//   - depth (int): The indentation depth of the value's key.
+//   - padding (int): The number of spaces between the key and a scalar value.
+//   - visited ([]uintptr): The addresses of the pointers, maps, and slices being
+//     expanded, from the outermost value to the value's parent.
+func (c *Console) writePrettyValue(buffer *bytes.Buffer, value interface{}, depth, padding int, visited []uintptr) {
+	if err, ok := value.(error); ok {
+		if !isJoinedError(err, unwrapErrors(err)) {
+			buffer.WriteString(strings.Repeat(" ", padding))
+		}
+
+		c.writeErrorTree(buffer, err, strings.Repeat(prettyIndent, depth+1))
+
+		return
+	}
+
+	if _, ok := value.(fmt.Stringer); ok {
+		c.writePrettyScalar(buffer, value, depth, padding)
+
+		return
+	}
+
+	v := reflect.ValueOf(value)
+
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			c.writePrettyScalar(buffer, value, depth, padding)
+
+			return
+		}
+
+		if v.Kind() == reflect.Pointer {
+			if slices.Contains(visited, v.Pointer()) {
+				c.writePrettyScalar(buffer, prettyCycle, depth, padding)
+
+				return
+			}
+
+			visited = append(visited, v.Pointer())
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+		c.writePrettyScalar(buffer, value, depth, padding)
+
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		if v.Len() == 0 {
+			break
+		}
+
+		if slices.Contains(visited, v.Pointer()) {
+			c.writePrettyScalar(buffer, prettyCycle, depth, padding)
+
+			return
+		}
+
+		visited = append(visited, v.Pointer())
+
+		fallthrough
+	case reflect.Struct, reflect.Array:
+		if depth >= prettyMaxDepth {
+			c.writePrettyScalar(buffer, prettyElided, depth, padding)
+
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Len() == 0 {
+			c.writePrettyScalar(buffer, "{}", depth, padding)
+
+			return
+		}
+
+		fields := make(Fields, 0, v.Len())
+
+		iterator := v.MapRange()
+
+		for iterator.Next() {
+			fields = append(fields, Field{Key: fmt.Sprint(iterator.Key().Interface()), Value: iterator.Value().Interface()})
+		}
+
+		c.writePrettyFields(buffer, fields.Sorted(), depth+1, visited)
+	case reflect.Struct:
+		fields := make(Fields, 0, v.NumField())
+
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				fields = append(fields, Field{Key: v.Type().Field(i).Name, Value: v.Field(i).Interface()})
+			}
+		}
+
+		if len(fields) == 0 {
+			c.writePrettyScalar(buffer, value, depth, padding)
+
+			return
+		}
+
+		c.writePrettyFields(buffer, fields, depth+1, visited)
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			c.writePrettyScalar(buffer, "[]", depth, padding)
+
+			return
+		}
+
+		for i := range v.Len() {
+			buffer.WriteByte('\n')
+			buffer.WriteString(strings.Repeat(prettyIndent, depth+1))
+			buffer.WriteByte('-')
+
+			c.writePrettyValue(buffer, v.Index(i).Interface(), depth+1, 1, visited)
+		}
+	default:
+		c.writePrettyScalar(buffer, value, depth, padding)
+	}
+}
+
+// writePrettyScalar writes a value using its fmt "%v" representation, padded to the
+// value column. Continuation lines of multi-line values are indented one level deeper
+// than the key.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - value (interface{}): The value to write.
+//   - depth (int): The indentation depth of the value's key.
+//   - padding (int): The number of spaces between the key and the value.
+func (c *Console) writePrettyScalar(buffer *bytes.Buffer, value interface{}, depth, padding int) {
+	buffer.WriteString(strings.Repeat(" ", padding))
+
+	str := fmt.Sprintf("%v", value)
+
+	buffer.WriteString(strings.ReplaceAll(str, "\n", "\n"+strings.Repeat(prettyIndent, depth+1)))
+}
+
+// writeErrorTree writes an error followed by the errors it wraps as a tree, one error
+// per line. Errors wrapping a single error (e.g., with fmt.Errorf and %w) have one child,
+// while errors joined with errors.Join have one child per joined error. A wrapping
+// error's message is shortened by the message of the error it wraps when it ends with
+// it, so each line shows only what that error adds. Errors created by errors.Join add
+// no message of their own, so their errors are shown directly.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - err (error): The error to write.
+//   - prefix (string): The prefix of the lines of the error's children.
+func (c *Console) writeErrorTree(buffer *bytes.Buffer, err error, prefix string) {
+	children := unwrapErrors(err)
+
+	message := err.Error()
+
+	if len(children) == 1 {
+		message = strings.TrimSuffix(message, ": "+children[0].Error())
+
+		if joined := unwrapErrors(children[0]); isJoinedError(children[0], joined) {
+			children = joined
+		}
+	}
+
+	if !isJoinedError(err, children) {
+		buffer.WriteString(strings.ReplaceAll(message, "\n", " "))
+	}
+
+	for i, child := range children {
+		branch, indent := "├─ ", "│  "
+
+		if i == len(children)-1 {
+			branch, indent = "└─ ", "   "
+		}
+
+		buffer.WriteByte('\n')
+		buffer.WriteString(prefix)
+		buffer.WriteString(branch)
+
+		c.writeErrorTree(buffer, child, prefix+indent)
+	}
+}
+
+// unwrapErrors returns the errors directly wrapped by err, supporting both the
+// Unwrap() error and Unwrap() []error forms.
+//
+// Parameters:
+//   - err (error): The error to unwrap.
+//
+// Returns:
+//   - errs ([]error): The wrapped errors, or nil if err wraps none.
+func unwrapErrors(err error) (errs []error) {
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		errs = e.Unwrap()
+	case interface{ Unwrap() error }:
+		if wrapped := e.Unwrap(); wrapped != nil {
+			errs = []error{wrapped}
+		}
+	}
 
 	return
 }
 
+// isJoinedError reports whether err only joins the given errors, as created by
+// errors.Join, i.e., whether its message is their messages separated by newlines.
+//
+// Parameters:
+//   - err (error): The error to check.
+//   - errs ([]error): The errors wrapped by err.
+//
+// Returns:
+//   - joined (bool): True if err adds no message of its own.
+func isJoinedError(err error, errs []error) (joined bool) {
+	if len(errs) < 2 {
+		return
+	}
+
+	messages := make([]string, len(errs))
+
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+
+	joined = err.Error() == strings.Join(messages, "\n")
+
+	return
+}
+
+const (
+	// prettyIndent is the indentation added per nesting level in PrettyPrint output.
+	prettyIndent = "  "
+	// prettyMaxDepth is the nesting depth beyond which values are no longer expanded
+	// in PrettyPrint output, guarding against deeply nested values.
+	prettyMaxDepth = 8
+	// prettyCycle is written in PrettyPrint output in place of a value that contains
+	// itself, where it refers back to the value being expanded.
+	prettyCycle = "<cycle>"
+	// prettyElided is written in PrettyPrint output in place of a map, slice, array, or
+	// struct nested deeper than prettyMaxDepth.
+	prettyElided = "..."
+)
+
 // ConsoleFormatterConfiguration defines configuration options for the Console formatter.
 // It controls the inclusion and formatting of timestamps, labels, metadata, and
 // colorization, allowing customization of the console output format.
@@ -142,7 +513,15 @@ func (c *Console) Format(log *Log) (data []byte, err error) {
 //   - IncludeLabel (bool): If true, includes a label (from metadata["label"]) in the output.
 //   - Colorize (bool): If true, enables colorization of labels using the Colorizer.
 //   - Colorizer (Colorizer): The Colorizer implementation used for applying colors to labels.
-//   - PrettyPrint (bool): If true, enables pretty-printing of output (currently unused).
+//   - PrettyPrint (bool): If true, writes the metadata as an indented, aligned block
+//     below the message, one key per line, with nested values expanded and errors
+//     rendered as a tree, instead of compact key=value pairs on the message line.
+//   - IncludeCaller (bool): If true, includes the caller's short "file:line" location
+//     before the message when the log carries one.
+//   - IncludeName (bool): If true, includes the name of the logger, followed by a colon,
+//     before the message when the log carries one.
+//   - SortKeys (bool): If true, metadata is written sorted alphabetically by key instead
+//     of in the order it was set.
 type ConsoleFormatterConfiguration struct {
 	IncludeTimestamp bool
 	TimestampFormat  string
@@ -150,14 +529,22 @@ type ConsoleFormatterConfiguration struct {
 	Colorize         bool
 	Colorizer        Colorizer
 	PrettyPrint      bool
+	IncludeCaller    bool
+	IncludeName      bool
+	SortKeys         bool
 }
 
-var _ Formatter = (*Console)(nil)
+var (
+	_ Formatter       = (*Console)(nil)
+	_ BufferFormatter = (*Console)(nil)
+)
 
 // DefaultConsoleConfig returns a default configuration for the Console formatter.
 // The default settings include a timestamp in RFC3339 format, label inclusion,
-// colorization with a no-op Colorizer, and disable pretty-printing. This provides
-// a sensible starting point for console logging that can be customized as needed.
+// colorization with a no-op Colorizer, caller inclusion (printed only when the logger
+// captures callers), name inclusion (printed only for named loggers), and disable
+// pretty-printing. This provides a sensible starting
+// point for console logging that can be customized as needed.
 //
 // Returns:
 //   - cfg (*ConsoleFormatterConfiguration): A pointer to the default configuration.
@@ -169,6 +556,9 @@ func DefaultConsoleConfig() (cfg *ConsoleFormatterConfiguration) {
 		Colorize:         true,
 		Colorizer:        NewNoOpColorizer(),
 		PrettyPrint:      false,
+		IncludeCaller:    true,
+		IncludeName:      true,
+		SortKeys:         false,
 	}
 
 	return
@@ -0,0 +1,166 @@
+package formatter_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+type prettyNode struct {
+	Name string
+	Next *prettyNode
+}
+
+type prettyHidden struct {
+	value int
+}
+
+func TestConsolePrettyPrint(t *testing.T) {
+	t.Parallel()
+
+	cyclicNode := &prettyNode{Name: "a"}
+	cyclicNode.Next = cyclicNode
+
+	cyclicMap := map[string]any{"k": 1}
+	cyclicMap["self"] = cyclicMap
+
+	cyclicSlice := []any{1, nil}
+	cyclicSlice[1] = cyclicSlice
+
+	deep := any("leaf")
+
+	for range 10 {
+		deep = []any{deep}
+	}
+
+	refused := errors.New("refused")
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{
+			name:  "nested values",
+			value: map[string]any{"x": []int{1, 2}, "y": map[string]int{}, "z": []string{}, "h": prettyHidden{value: 1}},
+			want: `
+  value:
+    h: {1}
+    x:
+      - 1
+      - 2
+    y: {}
+    z: []`,
+		},
+		{
+			name:  "struct",
+			value: prettyNode{Name: "a"},
+			want: `
+  value:
+    Name: a
+    Next: <nil>`,
+		},
+		{
+			name:  "nil pointer",
+			value: (*prettyNode)(nil),
+			want: `
+  value: <nil>`,
+		},
+		{
+			name:  "cyclic pointer",
+			value: cyclicNode,
+			want: `
+  value:
+    Name: a
+    Next: <cycle>`,
+		},
+		{
+			name:  "cyclic map",
+			value: cyclicMap,
+			want: `
+  value:
+    k:    1
+    self: <cycle>`,
+		},
+		{
+			name:  "cyclic slice",
+			value: cyclicSlice,
+			want: `
+  value:
+    - 1
+    - <cycle>`,
+		},
+		{
+			name:  "too deep",
+			value: deep,
+			want: `
+  value:
+    -
+      -
+        -
+          -
+            -
+              -
+                - ...`,
+		},
+		{
+			name:  "wrapped error",
+			value: fmt.Errorf("connect: %w", refused),
+			want: `
+  value: connect
+    └─ refused`,
+		},
+		{
+			name:  "joined errors",
+			value: errors.Join(errors.New("a"), errors.New("b")),
+			want: `
+  value:
+    ├─ a
+    └─ b`,
+		},
+		{
+			name:  "wrapped joined errors",
+			value: fmt.Errorf("setup: %w", errors.Join(fmt.Errorf("db: %w", refused), errors.New("cache down"))),
+			want: `
+  value: setup
+    ├─ db
+    │  └─ refused
+    └─ cache down`,
+		},
+	}
+
+	cfg := hqgologgerformatter.DefaultConsoleConfig()
+
+	cfg.IncludeTimestamp = false
+	cfg.Colorize = false
+	cfg.PrettyPrint = true
+
+	formatter := hqgologgerformatter.NewConsoleFormatter(cfg)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := formatter.Format(&hqgologgerformatter.Log{
+				Level:   hqgologgerlevels.LevelInfo,
+				Message: "first\nsecond",
+				Metadata: hqgologgerformatter.Fields{
+					{Key: "value", Value: test.value},
+					{Key: "n", Kind: hqgologgerformatter.FieldKindInt64, Integer: 3},
+				},
+			})
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+
+			want := "first\n  second" + test.want + "\n  n:     3"
+
+			if got := string(data); got != want {
+				t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
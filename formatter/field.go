@@ -0,0 +1,309 @@
+package formatter
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field is a single metadata key-value pair. Values of common types are stored in typed
+// slots rather than in an interface{}, which avoids allocating when the field is created;
+// Kind records which slot holds the value. A Field with the zero Kind (FieldKindAny)
+// holds its value in Value, so Field{Key: key, Value: value} is a valid field of any type.
+//
+// Fields:
+//   - Key (string): The metadata key.
+//   - Kind (FieldKind): The type of the value, selecting the slot that holds it.
+//   - Integer (int64): The value of FieldKindInt64, FieldKindFloat64 (as IEEE 754 bits),
+//     FieldKindBool (1 for true), FieldKindDuration, and FieldKindTime (as Unix
+//     nanoseconds) fields.
+//   - String (string): The value of FieldKindString fields, and the text of
+//     FieldKindBytes fields, sharing the memory of the byte slice.
+//   - Value (interface{}): The value of FieldKindAny and FieldKindStringer fields, and
+//     the *time.Location of FieldKindTime fields.
+type Field struct {
+	Key     string
+	Kind    FieldKind
+	Integer int64
+	String  string
+	Value   interface{}
+}
+
+// Any returns the field's value as an interface{}, converting typed values back to
+// their Go type (e.g., an int64 for FieldKindInt64 or a time.Time for FieldKindTime).
+// Converting a typed value allocates, so formatters should prefer AppendText or switch
+// on Kind.
+//
+// Returns:
+//   - value (interface{}): The field's value.
+func (f Field) Any() (value interface{}) {
+	switch f.Kind {
+	case FieldKindString:
+		value = f.String
+	case FieldKindInt64:
+		value = f.Integer
+	case FieldKindFloat64:
+		value = math.Float64frombits(uint64(f.Integer))
+	case FieldKindBool:
+		value = f.Integer == 1
+	case FieldKindDuration:
+		value = time.Duration(f.Integer)
+	case FieldKindTime:
+		value = f.time()
+	case FieldKindBytes:
+		value = []byte(f.String)
+	default:
+		value = f.Value
+	}
+
+	return
+}
+
+// AppendText appends the human-readable text form of the field's value to dst, as
+// used by the Console and Logfmt formatters. Typed values are appended without
+// allocating where possible: numbers and booleans in their strconv form, durations in
+// their time.Duration.String form, times in RFC3339Nano, and bytes as text. Stringers
+// use their String method, errors their Error method, and other values their fmt "%v"
+// representation.
+//
+// Parameters:
+//   - dst ([]byte): The buffer to append to.
+//
+// Returns:
+//   - text ([]byte): The extended buffer.
+func (f Field) AppendText(dst []byte) (text []byte) {
+	switch f.Kind {
+	case FieldKindString, FieldKindBytes:
+		text = append(dst, f.String...)
+	case FieldKindInt64:
+		text = strconv.AppendInt(dst, f.Integer, 10)
+	case FieldKindFloat64:
+		text = strconv.AppendFloat(dst, math.Float64frombits(uint64(f.Integer)), 'g', -1, 64)
+	case FieldKindBool:
+		text = strconv.AppendBool(dst, f.Integer == 1)
+	case FieldKindDuration:
+		text = append(dst, time.Duration(f.Integer).String()...)
+	case FieldKindTime:
+		text = f.time().AppendFormat(dst, time.RFC3339Nano)
+	case FieldKindStringer:
+		text = append(dst, f.stringer()...)
+	default:
+		switch v := f.Value.(type) {
+		case string:
+			text = append(dst, v...)
+		case error:
+			text = append(dst, v.Error()...)
+		case fmt.Stringer:
+			text = append(dst, v.String()...)
+		default:
+			text = fmt.Appendf(dst, "%v", v)
+		}
+	}
+
+	return
+}
+
+// IsNil reports whether the field holds no value, that is, whether it is a
+// FieldKindAny or FieldKindStringer field with a nil Value. Formatters skip such
+// fields.
+//
+// Returns:
+//   - isNil (bool): True if the field holds no value.
+func (f Field) IsNil() (isNil bool) {
+	switch f.Kind {
+	case FieldKindAny, FieldKindStringer:
+		isNil = f.Value == nil
+	}
+
+	return
+}
+
+// Equal reports whether two fields have the same key and value. Values held in Value
+// are compared with reflect.DeepEqual.
+//
+// Parameters:
+//   - other (Field): The field to compare with.
+//
+// Returns:
+//   - equal (bool): True if the fields are equal.
+func (f Field) Equal(other Field) (equal bool) {
+	equal = f.Key == other.Key && f.Kind == other.Kind && f.Integer == other.Integer &&
+		f.String == other.String && reflect.DeepEqual(f.Value, other.Value)
+
+	return
+}
+
+// time reconstructs the time.Time of a FieldKindTime field.
+//
+// Returns:
+//   - t (time.Time): The stored time.
+func (f Field) time() (t time.Time) {
+	t = time.Unix(0, f.Integer)
+
+	if location, ok := f.Value.(*time.Location); ok {
+		t = t.In(location)
+	}
+
+	return
+}
+
+// stringer returns the result of the String method of a FieldKindStringer field,
+// recovering from panics caused by nil receivers.
+//
+// Returns:
+//   - str (string): The string form of the value.
+func (f Field) stringer() (str string) {
+	stringer, ok := f.Value.(fmt.Stringer)
+	if !ok {
+		return
+	}
+
+	defer func() {
+		if recover() != nil {
+			str = "<nil>"
+		}
+	}()
+
+	str = stringer.String()
+
+	return
+}
+
+// FieldKind identifies the type of the value stored in a Field.
+type FieldKind uint8
+
+const (
+	// FieldKindAny is a value of any type, stored in Field.Value.
+	FieldKindAny FieldKind = iota
+	// FieldKindString is a string, stored in Field.String.
+	FieldKindString
+	// FieldKindInt64 is a signed integer, stored in Field.Integer.
+	FieldKindInt64
+	// FieldKindFloat64 is a float64, stored as its IEEE 754 bits in Field.Integer.
+	FieldKindFloat64
+	// FieldKindBool is a bool, stored in Field.Integer as 1 (true) or 0 (false).
+	FieldKindBool
+	// FieldKindDuration is a time.Duration, stored in Field.Integer.
+	FieldKindDuration
+	// FieldKindTime is a time.Time, stored as Unix nanoseconds in Field.Integer and its
+	// *time.Location in Field.Value.
+	FieldKindTime
+	// FieldKindStringer is a fmt.Stringer, stored in Field.Value and converted to a
+	// string only when formatted.
+	FieldKindStringer
+	// FieldKindBytes is a byte slice holding text, stored in Field.String without being
+	// copied.
+	FieldKindBytes
+)
+
+// Fields is an ordered list of metadata key-value pairs with unique keys. Unlike a map,
+// it preserves the order in which keys were first set, which keeps formatted output
+// stable between runs.
+type Fields []Field
+
+// Get returns the value stored under the given key, converted with Field.Any.
+//
+// Parameters:
+//   - key (string): The metadata key to look up.
+//
+// Returns:
+//   - value (interface{}): The stored value, or nil if the key is not set.
+//   - ok (bool): True if the key is set.
+func (f Fields) Get(key string) (value interface{}, ok bool) {
+	field, ok := f.Lookup(key)
+	if ok {
+		value = field.Any()
+	}
+
+	return
+}
+
+// Lookup returns the field stored under the given key.
+//
+// Parameters:
+//   - key (string): The metadata key to look up.
+//
+// Returns:
+//   - field (Field): The stored field, or the zero Field if the key is not set.
+//   - ok (bool): True if the key is set.
+func (f Fields) Lookup(key string) (field Field, ok bool) {
+	for i := range f {
+		if f[i].Key == key {
+			field, ok = f[i], true
+
+			return
+		}
+	}
+
+	return
+}
+
+// Set stores a value of any type under the given key, as a FieldKindAny field. See
+// SetField.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (interface{}): The metadata value.
+func (f *Fields) Set(key string, value interface{}) {
+	f.SetField(Field{Key: key, Value: value})
+}
+
+// SetField stores a field. If its key is already set, the stored field is replaced in
+// place, keeping its original position; otherwise the field is appended.
+//
+// Parameters:
+//   - field (Field): The field to store.
+func (f *Fields) SetField(field Field) {
+	for i := range *f {
+		if (*f)[i].Key == field.Key {
+			(*f)[i] = field
+
+			return
+		}
+	}
+
+	*f = append(*f, field)
+}
+
+// Delete removes the field stored under the given key, keeping the order of the other
+// fields. Deleting a key that is not set is a no-op.
+//
+// Parameters:
+//   - key (string): The metadata key.
+func (f *Fields) Delete(key string) {
+	*f = slices.DeleteFunc(*f, func(field Field) bool {
+		return field.Key == key
+	})
+}
+
+// Equal reports whether two sets of fields hold equal fields in the same order.
+//
+// Parameters:
+//   - other (Fields): The fields to compare with.
+//
+// Returns:
+//   - equal (bool): True if the fields are equal.
+func (f Fields) Equal(other Fields) (equal bool) {
+	equal = slices.EqualFunc(f, other, Field.Equal)
+
+	return
+}
+
+// Sorted returns a copy of the fields sorted alphabetically by key. The receiver is not
+// modified.
+//
+// Returns:
+//   - sorted (Fields): The sorted copy.
+func (f Fields) Sorted() (sorted Fields) {
+	sorted = slices.Clone(f)
+
+	slices.SortStableFunc(sorted, func(a, b Field) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return
+}
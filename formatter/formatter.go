@@ -1,6 +1,10 @@
 package formatter
 
 import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strconv"
 	"time"
 
 	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
@@ -21,16 +25,47 @@ import (
 //   - Message (string): The primary content of the log message, describing the
 //     event, condition, or error being logged. This is the main human-readable
 //     part of the log.
-//   - Metadata (map[string]interface{}): Optional key-value pairs providing
-//     additional context for the log message. Metadata can include structured
+//   - Metadata (Fields): Optional key-value pairs providing additional context for
+//     the log message, in the order they were set. Metadata can include structured
 //     data such as request IDs, user IDs, system metrics, or other relevant
-//     information to aid in debugging or analysis. The use of interface{} allows
-//     flexibility in the types of values stored.
+//     information to aid in debugging or analysis. Formatters write it in this
+//     order unless configured to sort keys, so output is deterministic.
+//   - Caller (*Caller): The source location that produced the log message, or nil if
+//     caller capture is disabled on the logger.
+//   - Name (string): The dot-separated name of the logger that produced the log message
+//     (e.g., "http.client"), identifying the component it comes from, or empty if the
+//     logger has none.
 type Log struct {
 	Timestamp time.Time
 	Level     hqgologgerlevels.Level
 	Message   string
-	Metadata  map[string]interface{}
+	Metadata  Fields
+	Caller    *Caller
+	Name      string
+}
+
+// Caller describes the source location of the code that produced a log message.
+//
+// Fields:
+//   - Function (string): The fully qualified name of the function (e.g.,
+//     "github.com/org/repo/pkg.(*Type).Method").
+//   - File (string): The absolute path of the source file.
+//   - Line (int): The line number within the file.
+type Caller struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// String returns the short form of the caller's location, the base name of the file
+// followed by the line number (e.g., "main.go:42"), as used in human-readable output.
+//
+// Returns:
+//   - location (string): The short "file:line" location.
+func (c *Caller) String() (location string) {
+	location = filepath.Base(c.File) + ":" + strconv.Itoa(c.Line)
+
+	return
 }
 
 // Formatter defines the interface for formatting log messages. Implementations
@@ -50,3 +85,23 @@ type Log struct {
 type Formatter interface {
 	Format(log *Log) (data []byte, err error)
 }
+
+// BufferFormatter is an optional interface implemented by formatters that can write a
+// formatted log message into a caller-provided buffer. The logger uses it to reuse
+// buffers across messages instead of allocating a new one for each, which reduces
+// allocations on the logging path. All formatters in this package implement it.
+//
+// Methods:
+//   - FormatTo(buffer *bytes.Buffer, log *Log) (err error): Writes the same output as
+//     Format to the end of the buffer. The buffer may be reused once the formatted
+//     output has been written, so implementations must not retain it.
+type BufferFormatter interface {
+	Formatter
+	FormatTo(buffer *bytes.Buffer, log *Log) (err error)
+}
+
+var (
+	// ErrInvalidLevel is an error returned by formatters when the Log passed to Format
+	// carries a Level outside the range defined by the levels package.
+	ErrInvalidLevel = errors.New("invalid log level")
+)
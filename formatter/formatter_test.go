@@ -0,0 +1,33 @@
+package formatter_test
+
+import (
+	"errors"
+	"testing"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+func TestFormatInvalidLevel(t *testing.T) {
+	t.Parallel()
+
+	formatters := map[string]hqgologgerformatter.Formatter{
+		"console": hqgologgerformatter.NewConsoleFormatter(nil),
+		"json":    hqgologgerformatter.NewJSONFormatter(nil),
+		"logfmt":  hqgologgerformatter.NewLogfmtFormatter(nil),
+	}
+
+	for name, formatter := range formatters {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := formatter.Format(&hqgologgerformatter.Log{
+				Level:   hqgologgerlevels.Level(42),
+				Message: "message",
+			})
+			if !errors.Is(err, hqgologgerformatter.ErrInvalidLevel) {
+				t.Errorf("Format() error = %v, want %v", err, hqgologgerformatter.ErrInvalidLevel)
+			}
+		})
+	}
+}
@@ -0,0 +1,557 @@
+package formatter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	hqgoerrors "github.com/hueristiq/hq-go-errors"
+)
+
+// JSON is an implementation of the Formatter interface that formats log messages
+// as single-line JSON objects, suitable for shipping to log collectors and other
+// machine consumers. The timestamp, level, and message are written first under
+// configurable keys, followed by the metadata, which is either nested under a
+// dedicated key or flattened into the top-level object. Errors stored in metadata
+// are encoded as structured objects rather than flat strings, including the type,
+// fields, and stack frames of hqgoerrors.Error values. The output does not include
+// a trailing newline, as this is typically handled by the log writer.
+//
+// Fields:
+//   - cfg (*JSONFormatterConfiguration): Configuration settings for the formatter,
+//     controlling key names, timestamp layout, metadata layout, and error encoding.
+type JSON struct {
+	cfg *JSONFormatterConfiguration
+}
+
+// Format converts a Log struct into a JSON-encoded byte slice. The object contains
+// the timestamp (omitted if zero or if TimestampKey is empty), the level as its
+// lowercase string, the message trimmed of trailing newlines, the logger name and the
+// caller (if any), and the metadata, in the order it was set or sorted by key if
+// SortKeys is enabled. When metadata is flattened into the object, keys colliding with
+// those of the standard fields are prefixed with JSONCollisionPrefix, so that the object
+// has no duplicate keys. The "label" metadata key is dropped unless IncludeLabel is set,
+// as the level is already part of the object. Values that cannot be encoded as JSON fall back to
+// their fmt "%v" representation instead of failing the whole log line.
+//
+// Parameters:
+//   - log (*Log): The log message to format, containing timestamp, level, message,
+//     and optional metadata.
+//
+// Returns:
+//   - data ([]byte): The formatted log message as a JSON object.
+//   - err (error): An error if the log level is invalid, otherwise nil.
+func (j *JSON) Format(log *Log) (data []byte, err error) {
+	buffer := &bytes.Buffer{}
+
+	if err = j.FormatTo(buffer, log); err != nil {
+		return
+	}
+
+	data = buffer.Bytes()
+
+	return
+}
+
+// FormatTo writes the JSON-encoded log message to the given buffer, as described for
+// Format, allowing the caller to reuse buffers across messages.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write the formatted log message to.
+//   - log (*Log): The log message to format.
+//
+// Returns:
+//   - err (error): An error if the log level is invalid, otherwise nil.
+func (j *JSON) FormatTo(buffer *bytes.Buffer, log *Log) (err error) {
+	if !log.Level.IsValid() {
+		err = fmt.Errorf("%w: %d", ErrInvalidLevel, log.Level)
+
+		return
+	}
+
+	buffer.Grow(len(log.Message) + 100)
+
+	buffer.WriteByte('{')
+
+	first := true
+
+	if j.cfg.TimestampKey != "" && !log.Timestamp.IsZero() {
+		j.writeKey(buffer, j.cfg.TimestampKey, &first)
+		j.writeTimestamp(buffer, log.Timestamp)
+	}
+
+	if j.cfg.LevelKey != "" {
+		j.writeKey(buffer, j.cfg.LevelKey, &first)
+		writeJSONString(buffer, log.Level.String())
+	}
+
+	if j.cfg.MessageKey != "" {
+		j.writeKey(buffer, j.cfg.MessageKey, &first)
+		writeJSONString(buffer, strings.TrimSuffix(log.Message, "\n"))
+	}
+
+	if j.cfg.NameKey != "" && log.Name != "" {
+		j.writeKey(buffer, j.cfg.NameKey, &first)
+		writeJSONString(buffer, log.Name)
+	}
+
+	if j.cfg.CallerKey != "" && log.Caller != nil {
+		j.writeKey(buffer, j.cfg.CallerKey, &first)
+
+		buffer.WriteByte('{')
+
+		nested := true
+
+		j.writeKey(buffer, "function", &nested)
+		writeJSONString(buffer, log.Caller.Function)
+		j.writeKey(buffer, "file", &nested)
+		writeJSONString(buffer, log.Caller.File)
+		j.writeKey(buffer, "line", &nested)
+
+		buffer.WriteString(strconv.Itoa(log.Caller.Line))
+		buffer.WriteByte('}')
+	}
+
+	metadata := log.Metadata
+
+	if j.cfg.SortKeys {
+		metadata = metadata.Sorted()
+	}
+
+	if j.hasMetadata(metadata) {
+		if j.cfg.MetadataKey != "" {
+			j.writeKey(buffer, j.cfg.MetadataKey, &first)
+
+			buffer.WriteByte('{')
+
+			nested := true
+
+			for _, field := range metadata {
+				if j.skipField(field) {
+					continue
+				}
+
+				j.writeKey(buffer, field.Key, &nested)
+				j.writeField(buffer, field)
+			}
+
+			buffer.WriteByte('}')
+		} else {
+			for _, field := range metadata {
+				if j.skipField(field) {
+					continue
+				}
+
+				key := field.Key
+
+				if j.reservedKey(key) {
+					key = JSONCollisionPrefix + key
+				}
+
+				j.writeKey(buffer, key, &first)
+				j.writeField(buffer, field)
+			}
+		}
+	}
+
+	buffer.WriteByte('}')
+
+	return
+}
+
+// hasMetadata reports whether any of the fields is written to the output.
+//
+// Parameters:
+//   - metadata (Fields): The fields to check.
+//
+// Returns:
+//   - has (bool): True if at least one field is not skipped.
+func (j *JSON) hasMetadata(metadata Fields) (has bool) {
+	for _, field := range metadata {
+		if !j.skipField(field) {
+			has = true
+
+			return
+		}
+	}
+
+	return
+}
+
+// skipField reports whether a field is left out of the output: fields with an empty
+// key or no value, and the "label" field unless IncludeLabel is set.
+//
+// Parameters:
+//   - field (Field): The field to check.
+//
+// Returns:
+//   - skip (bool): True if the field is not written.
+func (j *JSON) skipField(field Field) (skip bool) {
+	skip = field.Key == "" || field.IsNil() || (field.Key == "label" && !j.cfg.IncludeLabel)
+
+	return
+}
+
+// reservedKey reports whether a metadata key collides with the key of one of the
+// standard fields (timestamp, level, message, logger name, or caller), which would
+// produce duplicate keys if the metadata were written as is in the top-level object.
+//
+// Parameters:
+//   - key (string): The metadata key to check.
+//
+// Returns:
+//   - reserved (bool): True if the key is used by a standard field.
+func (j *JSON) reservedKey(key string) (reserved bool) {
+	switch key {
+	case j.cfg.TimestampKey, j.cfg.LevelKey, j.cfg.MessageKey, j.cfg.NameKey, j.cfg.CallerKey:
+		reserved = true
+	}
+
+	return
+}
+
+// writeKey writes a JSON object key followed by a colon, preceded by a comma unless
+// it is the first key of the enclosing object.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - key (string): The key to write.
+//   - first (*bool): Tracks whether the key is the first of its object; reset to false.
+func (j *JSON) writeKey(buffer *bytes.Buffer, key string, first *bool) {
+	if !*first {
+		buffer.WriteByte(',')
+	}
+
+	*first = false
+
+	writeJSONString(buffer, key)
+
+	buffer.WriteByte(':')
+}
+
+// writeTimestamp writes the timestamp using the configured layout. The epoch layouts
+// are written as JSON numbers; any other layout is passed to time.Time.Format and
+// written as a JSON string.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - t (time.Time): The timestamp to write.
+func (j *JSON) writeTimestamp(buffer *bytes.Buffer, t time.Time) {
+	switch j.cfg.TimestampFormat {
+	case TimestampFormatUnix:
+		buffer.WriteString(strconv.FormatInt(t.Unix(), 10))
+	case TimestampFormatUnixMilli:
+		buffer.WriteString(strconv.FormatInt(t.UnixMilli(), 10))
+	case TimestampFormatUnixNano:
+		buffer.WriteString(strconv.FormatInt(t.UnixNano(), 10))
+	default:
+		buffer.WriteByte('"')
+		buffer.Write(t.AppendFormat(buffer.AvailableBuffer(), j.cfg.TimestampFormat))
+		buffer.WriteByte('"')
+	}
+}
+
+// writeField writes the JSON-encoded value of a metadata field. Typed values are written
+// without going through encoding/json: integers, finite floats, and booleans as JSON
+// numbers and booleans, and strings, durations, times (in RFC3339Nano), stringers, and
+// bytes as JSON strings. Values of other types are written with writeValue.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - field (Field): The field whose value to write.
+func (j *JSON) writeField(buffer *bytes.Buffer, field Field) {
+	switch field.Kind {
+	case FieldKindString, FieldKindBytes:
+		writeJSONString(buffer, field.String)
+	case FieldKindInt64, FieldKindBool:
+		buffer.Write(field.AppendText(buffer.AvailableBuffer()))
+	case FieldKindFloat64:
+		if f := math.Float64frombits(uint64(field.Integer)); math.IsNaN(f) || math.IsInf(f, 0) {
+			buffer.WriteByte('"')
+			buffer.Write(field.AppendText(buffer.AvailableBuffer()))
+			buffer.WriteByte('"')
+		} else {
+			buffer.Write(field.AppendText(buffer.AvailableBuffer()))
+		}
+	case FieldKindDuration, FieldKindTime:
+		buffer.WriteByte('"')
+		buffer.Write(field.AppendText(buffer.AvailableBuffer()))
+		buffer.WriteByte('"')
+	case FieldKindStringer:
+		writeJSONString(buffer, string(field.AppendText(nil)))
+	default:
+		j.writeValue(buffer, field.Value)
+	}
+}
+
+// writeValue writes a JSON-encoded value. Errors are converted to structured objects
+// before encoding. HTML characters are not escaped, keeping messages containing URLs
+// or markup readable. If the value cannot be encoded, its "%v" representation is
+// written as a JSON string instead.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - value (interface{}): The value to encode.
+func (j *JSON) writeValue(buffer *bytes.Buffer, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		writeJSONString(buffer, v)
+
+		return
+	case error:
+		value = j.encodeError(v)
+	}
+
+	encoder, _ := _JSONEncoderPool.Get().(*_JSONEncoder)
+
+	defer _JSONEncoderPool.Put(encoder)
+
+	encoder.buffer.Reset()
+
+	if err := encoder.encoder.Encode(value); err != nil {
+		encoder.buffer.Reset()
+
+		_ = encoder.encoder.Encode(fmt.Sprintf("%v", value))
+	}
+
+	buffer.Write(bytes.TrimSuffix(encoder.buffer.Bytes(), []byte("\n")))
+}
+
+// encodeError converts an error into a JSON-encodable value. hqgoerrors.Error values
+// are expanded with hqgoerrors.ToJSON, which carries the error type, fields, and wrap
+// chain, plus stack frames when IncludeStackTrace is enabled. Other errors are
+// encoded as an object holding their message.
+//
+// Parameters:
+//   - err (error): The error to convert.
+//
+// Returns:
+//   - encoded (interface{}): A JSON-encodable representation of the error.
+func (j *JSON) encodeError(err error) (encoded interface{}) {
+	var hqErr hqgoerrors.Error
+
+	if hqgoerrors.As(err, &hqErr) {
+		if j.cfg.IncludeStackTrace {
+			encoded = hqgoerrors.ToJSON(err, hqgoerrors.FormatWithTrace())
+		} else {
+			encoded = hqgoerrors.ToJSON(err)
+		}
+
+		return
+	}
+
+	encoded = map[string]interface{}{
+		"message": err.Error(),
+	}
+
+	return
+}
+
+// _JSONEncoder is a reusable json.Encoder writing to its own buffer, kept in
+// _JSONEncoderPool to avoid allocating an encoder for every encoded value.
+//
+// Fields:
+//   - buffer (*bytes.Buffer): The buffer the encoder writes to.
+//   - encoder (*json.Encoder): The encoder, with HTML escaping disabled.
+type _JSONEncoder struct {
+	buffer  *bytes.Buffer
+	encoder *json.Encoder
+}
+
+// _JSONEncoderPool holds reusable _JSONEncoder instances.
+var _JSONEncoderPool = sync.Pool{
+	New: func() any {
+		buffer := &bytes.Buffer{}
+
+		encoder := json.NewEncoder(buffer)
+
+		encoder.SetEscapeHTML(false)
+
+		return &_JSONEncoder{
+			buffer:  buffer,
+			encoder: encoder,
+		}
+	},
+}
+
+// writeJSONString writes a string as a JSON string literal, escaping it the same way
+// as encoding/json with HTML escaping disabled: '"' and '\\' are backslash-escaped,
+// '\n', '\r' and '\t' use their short escapes, other control characters and the line
+// and paragraph separators U+2028 and U+2029 use \u escapes, and invalid UTF-8 is
+// replaced with U+FFFD.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - str (string): The string to write.
+func writeJSONString(buffer *bytes.Buffer, str string) {
+	const hex = "0123456789abcdef"
+
+	buffer.WriteByte('"')
+
+	start := 0
+
+	for i := 0; i < len(str); {
+		if b := str[i]; b < utf8.RuneSelf {
+			if b >= ' ' && b != '"' && b != '\\' {
+				i++
+
+				continue
+			}
+
+			buffer.WriteString(str[start:i])
+
+			switch b {
+			case '"', '\\':
+				buffer.WriteByte('\\')
+				buffer.WriteByte(b)
+			case '\n':
+				buffer.WriteString(`\n`)
+			case '\r':
+				buffer.WriteString(`\r`)
+			case '\t':
+				buffer.WriteString(`\t`)
+			default:
+				buffer.WriteString(`\u00`)
+				buffer.WriteByte(hex[b>>4])
+				buffer.WriteByte(hex[b&0xF])
+			}
+
+			i++
+			start = i
+
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(str[i:])
+
+		if r == utf8.RuneError && size == 1 {
+			buffer.WriteString(str[start:i])
+			buffer.WriteString(`\ufffd`)
+
+			i += size
+			start = i
+
+			continue
+		}
+
+		if r == '\u2028' || r == '\u2029' {
+			buffer.WriteString(str[start:i])
+			buffer.WriteString(`\u202`)
+			buffer.WriteByte(hex[r&0xF])
+
+			i += size
+			start = i
+
+			continue
+		}
+
+		i += size
+	}
+
+	buffer.WriteString(str[start:])
+	buffer.WriteByte('"')
+}
+
+// JSONFormatterConfiguration defines configuration options for the JSON formatter.
+// It controls the key names of the standard fields, the timestamp layout, how metadata
+// is laid out in the object, and how errors are encoded.
+//
+// Fields:
+//   - TimestampKey (string): The key for the timestamp. If empty, the timestamp is omitted.
+//   - TimestampFormat (string): The timestamp layout, either a time.Time.Format layout
+//     (e.g., time.RFC3339Nano) or one of TimestampFormatUnix, TimestampFormatUnixMilli,
+//     or TimestampFormatUnixNano for numeric epoch values.
+//   - LevelKey (string): The key for the level. If empty, the level is omitted.
+//   - MessageKey (string): The key for the message. If empty, the message is omitted.
+//   - NameKey (string): The key for the logger name, written when the log carries one. If
+//     empty, the name is omitted.
+//   - CallerKey (string): The key for the caller, written as an object with "function",
+//     "file", and "line" keys when the log carries one. If empty, the caller is omitted.
+//   - MetadataKey (string): The key under which metadata is nested. If empty, metadata
+//     is flattened into the top-level object, with the keys colliding with those of the
+//     standard fields prefixed with JSONCollisionPrefix.
+//   - IncludeLabel (bool): If true, the "label" metadata key is included in the output.
+//   - IncludeStackTrace (bool): If true, stack frames are included for hqgoerrors.Error values.
+//   - SortKeys (bool): If true, metadata is written sorted alphabetically by key instead
+//     of in the order it was set.
+type JSONFormatterConfiguration struct {
+	TimestampKey      string
+	TimestampFormat   string
+	LevelKey          string
+	MessageKey        string
+	NameKey           string
+	CallerKey         string
+	MetadataKey       string
+	IncludeLabel      bool
+	IncludeStackTrace bool
+	SortKeys          bool
+}
+
+const (
+	// TimestampFormatUnix renders timestamps as seconds since the Unix epoch.
+	TimestampFormatUnix = "unix"
+	// TimestampFormatUnixMilli renders timestamps as milliseconds since the Unix epoch.
+	TimestampFormatUnixMilli = "unix_milli"
+	// TimestampFormatUnixNano renders timestamps as nanoseconds since the Unix epoch.
+	TimestampFormatUnixNano = "unix_nano"
+	// JSONCollisionPrefix is prepended to the keys of flattened metadata that collide
+	// with the key of a standard field (e.g., "level" becomes "fields.level").
+	JSONCollisionPrefix = "fields."
+)
+
+var (
+	_ Formatter       = (*JSON)(nil)
+	_ BufferFormatter = (*JSON)(nil)
+)
+
+// DefaultJSONConfig returns a default configuration for the JSON formatter. The
+// default settings use the keys "timestamp", "level", "message", "logger", and "source",
+// RFC3339Nano timestamps, flattened metadata, no label, and stack traces for hqgoerrors.Error
+// values.
+//
+// Returns:
+//   - cfg (*JSONFormatterConfiguration): A pointer to the default configuration.
+func DefaultJSONConfig() (cfg *JSONFormatterConfiguration) {
+	cfg = &JSONFormatterConfiguration{
+		TimestampKey:      "timestamp",
+		TimestampFormat:   time.RFC3339Nano,
+		LevelKey:          "level",
+		MessageKey:        "message",
+		NameKey:           "logger",
+		CallerKey:         "source",
+		MetadataKey:       "",
+		IncludeLabel:      false,
+		IncludeStackTrace: true,
+		SortKeys:          false,
+	}
+
+	return
+}
+
+// NewJSONFormatter creates and returns a new JSON formatter instance, configured
+// with the provided JSONFormatterConfiguration. If no configuration is provided
+// (i.e., cfg is nil), it uses the default configuration from DefaultJSONConfig.
+//
+// Parameters:
+//   - cfg (*JSONFormatterConfiguration): The configuration for the formatter.
+//     If nil, defaults are applied.
+//
+// Returns:
+//   - formatter (*JSON): A pointer to a new JSON formatter instance.
+func NewJSONFormatter(cfg *JSONFormatterConfiguration) (formatter *JSON) {
+	if cfg == nil {
+		cfg = DefaultJSONConfig()
+	}
+
+	formatter = &JSON{
+		cfg: cfg,
+	}
+
+	return
+}
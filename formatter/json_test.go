@@ -0,0 +1,87 @@
+package formatter_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+func TestJSONFormatReservedKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		metadataKey string
+		want        map[string]any
+	}{
+		{
+			name:        "flattened",
+			metadataKey: "",
+			want: map[string]any{
+				"timestamp":        "2025-01-01T00:00:00Z",
+				"level":            "info",
+				"message":          "served",
+				"fields.level":     "custom",
+				"fields.message":   "custom",
+				"fields.timestamp": "custom",
+				"status":           float64(200),
+			},
+		},
+		{
+			name:        "nested",
+			metadataKey: "fields",
+			want: map[string]any{
+				"timestamp": "2025-01-01T00:00:00Z",
+				"level":     "info",
+				"message":   "served",
+				"fields": map[string]any{
+					"level":     "custom",
+					"message":   "custom",
+					"timestamp": "custom",
+					"status":    float64(200),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := hqgologgerformatter.DefaultJSONConfig()
+
+			cfg.MetadataKey = test.metadataKey
+
+			var metadata hqgologgerformatter.Fields
+
+			metadata.Set("level", "custom")
+			metadata.Set("message", "custom")
+			metadata.Set("timestamp", "custom")
+			metadata.SetField(hqgologgerformatter.Field{Key: "status", Kind: hqgologgerformatter.FieldKindInt64, Integer: 200})
+
+			data, err := hqgologgerformatter.NewJSONFormatter(cfg).Format(&hqgologgerformatter.Log{
+				Timestamp: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				Level:     hqgologgerlevels.LevelInfo,
+				Message:   "served",
+				Metadata:  metadata,
+			})
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+
+			got := map[string]any{}
+
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("invalid JSON %s: %v", data, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Format() = %s, want %v", data, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Logfmt is an implementation of the Formatter interface that formats log messages
+// in the logfmt format (e.g., `ts=2025-08-08T13:45:00Z level=info msg="request done" status=200`).
+// Every field is written as a key=value pair separated by single spaces. Values are
+// quoted when they are empty or contain spaces, quotes, '=' or control characters,
+// and quoted values escape backslashes, quotes and control characters, so the output
+// can be parsed back by standard logfmt tools. Keys containing characters that are
+// not allowed in logfmt keys have them replaced with underscores. The output does
+// not include a trailing newline, as this is typically handled by the log writer.
+//
+// Fields:
+//   - cfg (*LogfmtFormatterConfiguration): Configuration settings for the formatter,
+//     controlling key names, timestamp layout, and label handling.
+type Logfmt struct {
+	cfg *LogfmtFormatterConfiguration
+}
+
+// Format converts a Log struct into a logfmt-encoded byte slice. The timestamp
+// (omitted if zero or if TimestampKey is empty), level, message (trimmed of trailing
+// newlines), logger name (if any), and caller as "file:line" (if any) come first,
+// followed by the metadata in the order it was set, or sorted by key if SortKeys is
+// enabled. The "label" metadata key is dropped unless IncludeLabel is set, as the level
+// is already part of the line.
+// Errors are rendered using their Error method; other values use their fmt "%v"
+// representation.
+//
+// Parameters:
+//   - log (*Log): The log message to format, containing timestamp, level, message,
+//     and optional metadata.
+//
+// Returns:
+//   - data ([]byte): The formatted log message as a logfmt line.
+//   - err (error): An error if the log level is invalid, otherwise nil.
+func (l *Logfmt) Format(log *Log) (data []byte, err error) {
+	buffer := &bytes.Buffer{}
+
+	if err = l.FormatTo(buffer, log); err != nil {
+		return
+	}
+
+	data = buffer.Bytes()
+
+	return
+}
+
+// FormatTo writes the logfmt-encoded log message to the given buffer, as described for
+// Format, allowing the caller to reuse buffers across messages.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write the formatted log message to.
+//   - log (*Log): The log message to format.
+//
+// Returns:
+//   - err (error): An error if the log level is invalid, otherwise nil.
+func (l *Logfmt) FormatTo(buffer *bytes.Buffer, log *Log) (err error) {
+	if !log.Level.IsValid() {
+		err = fmt.Errorf("%w: %d", ErrInvalidLevel, log.Level)
+
+		return
+	}
+
+	start := buffer.Len()
+
+	buffer.Grow(len(log.Message) + 100)
+
+	if l.cfg.TimestampKey != "" && !log.Timestamp.IsZero() {
+		l.writeKey(buffer, start, l.cfg.TimestampKey)
+
+		timestamp := log.Timestamp.AppendFormat(buffer.AvailableBuffer(), l.cfg.TimestampFormat)
+
+		if len(timestamp) > 0 && !bytes.ContainsFunc(timestamp, logfmtNeedsQuotingRune) {
+			buffer.Write(timestamp)
+		} else {
+			writeLogfmtValue(buffer, string(timestamp))
+		}
+	}
+
+	if l.cfg.LevelKey != "" {
+		l.writePair(buffer, start, l.cfg.LevelKey, log.Level.String())
+	}
+
+	if l.cfg.MessageKey != "" {
+		l.writePair(buffer, start, l.cfg.MessageKey, strings.TrimSuffix(log.Message, "\n"))
+	}
+
+	if l.cfg.NameKey != "" && log.Name != "" {
+		l.writePair(buffer, start, l.cfg.NameKey, log.Name)
+	}
+
+	if l.cfg.CallerKey != "" && log.Caller != nil {
+		l.writePair(buffer, start, l.cfg.CallerKey, log.Caller.File+":"+strconv.Itoa(log.Caller.Line))
+	}
+
+	metadata := log.Metadata
+
+	if l.cfg.SortKeys {
+		metadata = metadata.Sorted()
+	}
+
+	for _, field := range metadata {
+		if field.Key == "" || field.IsNil() {
+			continue
+		}
+
+		if field.Key == "label" && !l.cfg.IncludeLabel {
+			continue
+		}
+
+		switch field.Kind {
+		case FieldKindString, FieldKindBytes:
+			l.writePair(buffer, start, field.Key, field.String)
+		case FieldKindInt64, FieldKindFloat64, FieldKindBool, FieldKindDuration, FieldKindTime:
+			// The text forms of these kinds never need quoting.
+			l.writeKey(buffer, start, field.Key)
+
+			buffer.Write(field.AppendText(buffer.AvailableBuffer()))
+		default:
+			l.writePair(buffer, start, field.Key, string(field.AppendText(nil)))
+		}
+	}
+
+	return
+}
+
+// writePair writes a single key=value pair, preceded by a space unless it is the
+// first pair of the line.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - start (int): The offset in the buffer at which the line starts.
+//   - key (string): The key, sanitized before writing.
+//   - value (string): The value, quoted and escaped if required.
+func (l *Logfmt) writePair(buffer *bytes.Buffer, start int, key, value string) {
+	l.writeKey(buffer, start, key)
+
+	writeLogfmtValue(buffer, value)
+}
+
+// writeKey writes a key followed by '=', preceded by a space unless it is the first
+// key of the line.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - start (int): The offset in the buffer at which the line starts.
+//   - key (string): The key, sanitized before writing.
+func (l *Logfmt) writeKey(buffer *bytes.Buffer, start int, key string) {
+	if buffer.Len() > start {
+		buffer.WriteByte(' ')
+	}
+
+	writeLogfmtKey(buffer, key)
+
+	buffer.WriteByte('=')
+}
+
+// LogfmtFormatterConfiguration defines configuration options for the Logfmt formatter.
+// It controls the key names of the standard fields, the timestamp layout, and whether
+// the label is included.
+//
+// Fields:
+//   - TimestampKey (string): The key for the timestamp. If empty, the timestamp is omitted.
+//   - TimestampFormat (string): The format for timestamps (e.g., time.RFC3339).
+//   - LevelKey (string): The key for the level. If empty, the level is omitted.
+//   - MessageKey (string): The key for the message. If empty, the message is omitted.
+//   - NameKey (string): The key for the logger name, written when the log carries one.
+//     If empty, the name is omitted.
+//   - CallerKey (string): The key for the caller's "file:line" location, written when
+//     the log carries one. If empty, the caller is omitted.
+//   - IncludeLabel (bool): If true, the "label" metadata key is included in the output.
+//   - SortKeys (bool): If true, metadata is written sorted alphabetically by key instead
+//     of in the order it was set.
+type LogfmtFormatterConfiguration struct {
+	TimestampKey    string
+	TimestampFormat string
+	LevelKey        string
+	MessageKey      string
+	NameKey         string
+	CallerKey       string
+	IncludeLabel    bool
+	SortKeys        bool
+}
+
+var (
+	_ Formatter       = (*Logfmt)(nil)
+	_ BufferFormatter = (*Logfmt)(nil)
+)
+
+// DefaultLogfmtConfig returns a default configuration for the Logfmt formatter. The
+// default settings use the conventional keys "ts", "level", and "msg", the keys
+// "logger" for the logger name and "source" for the caller, RFC3339 timestamps, and no label.
+//
+// Returns:
+//   - cfg (*LogfmtFormatterConfiguration): A pointer to the default configuration.
+func DefaultLogfmtConfig() (cfg *LogfmtFormatterConfiguration) {
+	cfg = &LogfmtFormatterConfiguration{
+		TimestampKey:    "ts",
+		TimestampFormat: time.RFC3339,
+		LevelKey:        "level",
+		MessageKey:      "msg",
+		NameKey:         "logger",
+		CallerKey:       "source",
+		IncludeLabel:    false,
+		SortKeys:        false,
+	}
+
+	return
+}
+
+// NewLogfmtFormatter creates and returns a new Logfmt formatter instance, configured
+// with the provided LogfmtFormatterConfiguration. If no configuration is provided
+// (i.e., cfg is nil), it uses the default configuration from DefaultLogfmtConfig.
+//
+// Parameters:
+//   - cfg (*LogfmtFormatterConfiguration): The configuration for the formatter.
+//     If nil, defaults are applied.
+//
+// Returns:
+//   - formatter (*Logfmt): A pointer to a new Logfmt formatter instance.
+func NewLogfmtFormatter(cfg *LogfmtFormatterConfiguration) (formatter *Logfmt) {
+	if cfg == nil {
+		cfg = DefaultLogfmtConfig()
+	}
+
+	formatter = &Logfmt{
+		cfg: cfg,
+	}
+
+	return
+}
+
+// writeLogfmtKey writes a logfmt key, replacing spaces, '=', '"' and control or
+// invalid characters with underscores. An empty key is written as "_".
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - key (string): The key to write.
+func writeLogfmtKey(buffer *bytes.Buffer, key string) {
+	if key == "" {
+		buffer.WriteByte('_')
+
+		return
+	}
+
+	for _, r := range key {
+		if r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError || unicode.IsControl(r) {
+			buffer.WriteByte('_')
+
+			continue
+		}
+
+		buffer.WriteRune(r)
+	}
+}
+
+// writeLogfmtValue writes a logfmt value, quoting it if it is empty or contains a
+// space, '=', '"', a control character, or invalid UTF-8. Inside quotes, '\\' and '"'
+// are backslash-escaped, '\n', '\r' and '\t' use their short escapes, and other
+// control characters and invalid bytes use \u escapes.
+//
+// Parameters:
+//   - buffer (*bytes.Buffer): The buffer to write to.
+//   - value (string): The value to write.
+func writeLogfmtValue(buffer *bytes.Buffer, value string) {
+	if !logfmtNeedsQuoting(value) {
+		buffer.WriteString(value)
+
+		return
+	}
+
+	buffer.WriteByte('"')
+
+	for i := 0; i < len(value); {
+		r, size := utf8.DecodeRuneInString(value[i:])
+
+		switch {
+		case r == utf8.RuneError && size == 1:
+			buffer.WriteString(`\ufffd`)
+		case r == '\\' || r == '"':
+			buffer.WriteByte('\\')
+			buffer.WriteRune(r)
+		case r == '\n':
+			buffer.WriteString(`\n`)
+		case r == '\r':
+			buffer.WriteString(`\r`)
+		case r == '\t':
+			buffer.WriteString(`\t`)
+		case unicode.IsControl(r):
+			buffer.WriteString(`\u`)
+
+			hex := strconv.FormatInt(int64(r), 16)
+
+			buffer.WriteString(strings.Repeat("0", 4-len(hex)))
+			buffer.WriteString(hex)
+		default:
+			buffer.WriteString(value[i : i+size])
+		}
+
+		i += size
+	}
+
+	buffer.WriteByte('"')
+}
+
+// logfmtNeedsQuoting reports whether a logfmt value must be quoted.
+//
+// Parameters:
+//   - value (string): The value to check.
+//
+// Returns:
+//   - needs (bool): True if the value is empty or contains characters that are not
+//     allowed in an unquoted logfmt value.
+func logfmtNeedsQuoting(value string) (needs bool) {
+	if value == "" {
+		needs = true
+
+		return
+	}
+
+	for _, r := range value {
+		if logfmtNeedsQuotingRune(r) {
+			needs = true
+
+			return
+		}
+	}
+
+	return
+}
+
+// logfmtNeedsQuotingRune reports whether a character requires a logfmt value containing
+// it to be quoted.
+//
+// Parameters:
+//   - r (rune): The character to check.
+//
+// Returns:
+//   - needs (bool): True if the character is not allowed in an unquoted logfmt value.
+func logfmtNeedsQuotingRune(r rune) (needs bool) {
+	needs = r <= ' ' || r == '=' || r == '"' || r == '\\' || r == utf8.RuneError || unicode.IsControl(r)
+
+	return
+}
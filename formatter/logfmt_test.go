@@ -0,0 +1,136 @@
+package formatter_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+func TestLogfmtFormatValues(t *testing.T) {
+	t.Parallel()
+
+	type point struct {
+		X, Y int
+	}
+
+	tests := []struct {
+		name  string
+		field hqgologgerformatter.Field
+		want  string
+	}{
+		{name: "bare", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: "plain"}, want: `k=plain`},
+		{name: "empty", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: ""}, want: `k=""`},
+		{name: "space", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: "a b"}, want: `k="a b"`},
+		{name: "equals", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: "a=b"}, want: `k="a=b"`},
+		{name: "quote", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: `say "hi"`}, want: `k="say \"hi\""`},
+		{name: "backslash", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: `C:\dir`}, want: `k="C:\\dir"`},
+		{name: "newline", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: "a\nb\r\tc"}, want: `k="a\nb\r\tc"`},
+		{name: "control", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: "a\x00b\x1b"}, want: `k="a\u0000b\u001b"`},
+		{name: "invalid utf-8", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: "a\xffb"}, want: `k="a\ufffdb"`},
+		{name: "unicode", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindString, String: "héllo"}, want: `k=héllo`},
+		{name: "key sanitized", field: hqgologgerformatter.Field{Key: "a b=\"c\"\n", Kind: hqgologgerformatter.FieldKindString, String: "v"}, want: `a_b__c__=v`},
+		{name: "int", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindInt64, Integer: -42}, want: `k=-42`},
+		{name: "duration", field: hqgologgerformatter.Field{Key: "k", Kind: hqgologgerformatter.FieldKindDuration, Integer: int64(1500 * time.Millisecond)}, want: `k=1.5s`},
+		{name: "error", field: hqgologgerformatter.Field{Key: "error", Value: errors.New("connection reset")}, want: `error="connection reset"`},
+		{name: "nil", field: hqgologgerformatter.Field{Key: "k", Value: nil}, want: ``},
+		{name: "map", field: hqgologgerformatter.Field{Key: "k", Value: map[string]int{"a": 1, "b": 2}}, want: `k="map[a:1 b:2]"`},
+		{name: "single entry map", field: hqgologgerformatter.Field{Key: "k", Value: map[string]int{"a": 1}}, want: `k=map[a:1]`},
+		{name: "nested map", field: hqgologgerformatter.Field{Key: "k", Value: map[string]any{"a": map[string]string{"b": "c d"}}}, want: `k="map[a:map[b:c d]]"`},
+		{name: "slice", field: hqgologgerformatter.Field{Key: "k", Value: []string{"a", "b"}}, want: `k="[a b]"`},
+		{name: "struct", field: hqgologgerformatter.Field{Key: "k", Value: point{X: 1, Y: 2}}, want: `k="{1 2}"`},
+	}
+
+	cfg := hqgologgerformatter.DefaultLogfmtConfig()
+
+	cfg.TimestampKey = ""
+	cfg.LevelKey = ""
+	cfg.MessageKey = ""
+
+	formatter := hqgologgerformatter.NewLogfmtFormatter(cfg)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := formatter.Format(&hqgologgerformatter.Log{
+				Level:    hqgologgerlevels.LevelInfo,
+				Metadata: hqgologgerformatter.Fields{test.field},
+			})
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+
+			if got := string(data); got != test.want {
+				t.Errorf("Format() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLogfmtFormatLine(t *testing.T) {
+	t.Parallel()
+
+	log := &hqgologgerformatter.Log{
+		Timestamp: time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC),
+		Level:     hqgologgerlevels.LevelWarn,
+		Message:   "slow query\n",
+		Name:      "db",
+		Caller:    &hqgologgerformatter.Caller{File: "db/query.go", Line: 42},
+		Metadata: hqgologgerformatter.Fields{
+			{Key: "table", Kind: hqgologgerformatter.FieldKindString, String: "users"},
+			{Key: "label", Kind: hqgologgerformatter.FieldKindString, String: "WRN"},
+			{Key: "attempt", Kind: hqgologgerformatter.FieldKindInt64, Integer: 2},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		configure func(cfg *hqgologgerformatter.LogfmtFormatterConfiguration)
+		want      string
+	}{
+		{
+			name:      "default",
+			configure: func(*hqgologgerformatter.LogfmtFormatterConfiguration) {},
+			want:      `ts=2025-01-02T03:04:05Z level=warn msg="slow query" logger=db source=db/query.go:42 table=users attempt=2`,
+		},
+		{
+			name: "sorted with label",
+			configure: func(cfg *hqgologgerformatter.LogfmtFormatterConfiguration) {
+				cfg.SortKeys = true
+				cfg.IncludeLabel = true
+			},
+			want: `ts=2025-01-02T03:04:05Z level=warn msg="slow query" logger=db source=db/query.go:42 attempt=2 label=WRN table=users`,
+		},
+		{
+			name: "quoted timestamp",
+			configure: func(cfg *hqgologgerformatter.LogfmtFormatterConfiguration) {
+				cfg.TimestampFormat = time.ANSIC
+				cfg.NameKey = ""
+				cfg.CallerKey = ""
+			},
+			want: `ts="Thu Jan  2 03:04:05 2025" level=warn msg="slow query" table=users attempt=2`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := hqgologgerformatter.DefaultLogfmtConfig()
+
+			test.configure(cfg)
+
+			data, err := hqgologgerformatter.NewLogfmtFormatter(cfg).Format(log)
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+
+			if got := string(data); got != test.want {
+				t.Errorf("Format() =\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package logger_test
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+// logGoldenEvents logs a fixed set of events covering each level and field kind.
+func logGoldenEvents(logger *hqgologger.Logger) {
+	logger.Trace("tracing", hqgologger.WithBool("verbose", true))
+	logger.Debug("resolved config", hqgologger.WithString("path", "/etc/app.yaml"))
+	logger.Info("listening", hqgologger.WithInt("port", 8080), hqgologger.WithFloat64("ratio", 0.25))
+	logger.Named("db").Warn("slow query", hqgologger.WithDuration("elapsed", 1500*time.Millisecond))
+	logger.Named("db").Error("query failed", hqgologger.WithError(errors.New("connection reset")))
+	logger.Print("plain \"quoted\" message", hqgologger.WithString("note", "has spaces"))
+	logger.Fatal("giving up", hqgologger.WithInt64("attempts", 3))
+}
+
+func TestFormatterGolden(t *testing.T) {
+	t.Parallel()
+
+	consoleCfg := hqgologgerformatter.DefaultConsoleConfig()
+
+	consoleCfg.Colorize = false
+	consoleCfg.TimestampFormat = time.RFC3339Nano
+
+	tests := []struct {
+		name      string
+		formatter hqgologgerformatter.Formatter
+	}{
+		{name: "console", formatter: hqgologgerformatter.NewConsoleFormatter(consoleCfg)},
+		{name: "json", formatter: hqgologgerformatter.NewJSONFormatter(nil)},
+		{name: "logfmt", formatter: hqgologgerformatter.NewLogfmtFormatter(nil)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			cfg := hqgologgerwriter.DefaultConsoleWriterConfig()
+
+			cfg.Stdout = &output
+			cfg.Stderr = &output
+
+			logger := hqgologger.NewLogger()
+
+			logger.SetLevel(hqgologgerlevels.LevelTrace)
+			logger.SetClock(hqgologgerloggertest.NewClock(time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC), 250*time.Millisecond))
+			logger.SetFormatter(test.formatter)
+			logger.SetWriter(hqgologgerwriter.NewConsoleWriter(cfg))
+			logger.SetExitFunc(func(int) {})
+
+			logGoldenEvents(logger)
+
+			hqgologgerloggertest.AssertGolden(t, filepath.Join("testdata", test.name+".golden"), output.Bytes())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package logger
+
+import (
+	"slices"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// Hook runs user code for the events of a Logger at the levels it is scoped to (see
+// Logger.AddHook). A hook may run before formatting, to enrich or rewrite the event
+// (e.g., add metadata, change its level) or veto it, and after writing, to trigger side
+// effects such as incrementing metrics or alerting on LevelFatal events. A Hook is
+// immutable once created and is safe for concurrent use as long as its functions are.
+//
+// Fields:
+//   - cfg (*HookConfiguration): Configuration settings for the hook, holding its levels
+//     and functions.
+type Hook struct {
+	cfg *HookConfiguration
+}
+
+// Enabled reports whether the hook is scoped to the given level.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level to check.
+//
+// Returns:
+//   - enabled (bool): True if the hook runs for events at the level.
+func (h *Hook) Enabled(level hqgologgerlevels.Level) (enabled bool) {
+	enabled = len(h.cfg.Levels) == 0 || slices.Contains(h.cfg.Levels, level)
+
+	return
+}
+
+// BeforeFormat runs the hook's BeforeFormat function on the event if the hook is scoped
+// to the event's level.
+//
+// Parameters:
+//   - event (*Event): The event about to be formatted.
+//
+// Returns:
+//   - keep (bool): False if the hook vetoes the event, otherwise true.
+func (h *Hook) BeforeFormat(event *Event) (keep bool) {
+	keep = true
+
+	if h.cfg.BeforeFormat != nil && h.Enabled(event.level) {
+		keep = h.cfg.BeforeFormat(event)
+	}
+
+	return
+}
+
+// AfterWrite runs the hook's AfterWrite function on the event if the hook is scoped to
+// the event's level.
+//
+// Parameters:
+//   - event (*Event): The event that was written.
+//   - err (error): The last error encountered while formatting or writing the event.
+func (h *Hook) AfterWrite(event *Event, err error) {
+	if h.cfg.AfterWrite != nil && h.Enabled(event.level) {
+		h.cfg.AfterWrite(event, err)
+	}
+}
+
+// BeforeFormatFunc is run on an event before it is formatted. It may modify the event
+// through its mutators, including its level, and decide whether it is logged at all.
+//
+// Parameters:
+//   - event (*Event): The event about to be formatted. It must not be retained after
+//     the function returns, as the logger may reuse it.
+//
+// Returns:
+//   - keep (bool): False to veto the event, which is then neither written nor passed to
+//     later hooks.
+type BeforeFormatFunc func(event *Event) (keep bool)
+
+// AfterWriteFunc is run on an event after it has been written by the logger and its
+// sinks.
+//
+// Parameters:
+//   - event (*Event): The event that was written. It must not be modified or retained
+//     after the function returns, as the logger may reuse it.
+//   - err (error): The last error encountered while formatting or writing the event, or
+//     nil if all writes succeeded.
+type AfterWriteFunc func(event *Event, err error)
+
+// HookConfiguration defines configuration options for a Hook.
+//
+// Fields:
+//   - Levels ([]hqgologgerlevels.Level): The levels the hook is scoped to. If empty, the
+//     hook runs for all levels.
+//   - BeforeFormat (BeforeFormatFunc): An optional function run before the event is
+//     formatted.
+//   - AfterWrite (AfterWriteFunc): An optional function run after the event is written.
+type HookConfiguration struct {
+	Levels       []hqgologgerlevels.Level
+	BeforeFormat BeforeFormatFunc
+	AfterWrite   AfterWriteFunc
+}
+
+// DefaultHookConfig returns a default configuration for a Hook. The default settings
+// scope the hook to all levels and set no functions, so they are meant to be completed
+// by the caller.
+//
+// Returns:
+//   - cfg (*HookConfiguration): A pointer to the default configuration.
+func DefaultHookConfig() (cfg *HookConfiguration) {
+	cfg = &HookConfiguration{
+		Levels:       nil,
+		BeforeFormat: nil,
+		AfterWrite:   nil,
+	}
+
+	return
+}
+
+// NewHook creates and returns a new Hook. If no configuration is provided (i.e., cfg is
+// nil), it uses the default configuration from DefaultHookConfig. The configuration
+// must not be modified after the hook is created.
+//
+// Parameters:
+//   - cfg (*HookConfiguration): The configuration for the hook. If nil, defaults are
+//     applied.
+//
+// Returns:
+//   - hook (*Hook): A pointer to a new Hook instance.
+func NewHook(cfg *HookConfiguration) (hook *Hook) {
+	if cfg == nil {
+		cfg = DefaultHookConfig()
+	}
+
+	hook = &Hook{
+		cfg: cfg,
+	}
+
+	return
+}
@@ -0,0 +1,208 @@
+package logger_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestHookVeto(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	var later, after int
+
+	logger.AddHook(
+		hqgologger.NewHook(&hqgologger.HookConfiguration{
+			BeforeFormat: func(event *hqgologger.Event) (keep bool) {
+				_, secret := event.Value("secret")
+
+				keep = !secret
+
+				return
+			},
+		}),
+		hqgologger.NewHook(&hqgologger.HookConfiguration{
+			BeforeFormat: func(*hqgologger.Event) (keep bool) {
+				later++
+
+				keep = true
+
+				return
+			},
+			AfterWrite: func(*hqgologger.Event, error) {
+				after++
+			},
+		}),
+	)
+
+	logger.Info("kept")
+	logger.Info("vetoed", hqgologger.WithBool("secret", true))
+
+	if got, want := recorder.All().Messages(), []string{"kept"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %q, want %q", got, want)
+	}
+
+	if later != 1 || after != 1 {
+		t.Errorf("later hook ran %d times before formatting and %d after writing, want 1 and 1", later, after)
+	}
+}
+
+func TestHookRewritesEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rewrite func(event *hqgologger.Event)
+		want    []string
+		level   hqgologgerlevels.Level
+	}{
+		{
+			name: "message",
+			rewrite: func(event *hqgologger.Event) {
+				event.SetMessage("rewritten: " + event.Message())
+			},
+			want:  []string{"rewritten: original"},
+			level: hqgologgerlevels.LevelInfo,
+		},
+		{
+			name: "raised level",
+			rewrite: func(event *hqgologger.Event) {
+				event.SetLevel(hqgologgerlevels.LevelError)
+			},
+			want:  []string{"original"},
+			level: hqgologgerlevels.LevelError,
+		},
+		{
+			name: "lowered level below the threshold",
+			rewrite: func(event *hqgologger.Event) {
+				event.SetLevel(hqgologgerlevels.LevelDebug)
+			},
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger, recorder := hqgologgerloggertest.New(t)
+
+			logger.SetLevel(hqgologgerlevels.LevelInfo)
+			logger.AddHook(hqgologger.NewHook(&hqgologger.HookConfiguration{
+				BeforeFormat: func(event *hqgologger.Event) (keep bool) {
+					test.rewrite(event)
+
+					keep = true
+
+					return
+				},
+			}))
+
+			logger.Info("original")
+
+			entries := recorder.All()
+
+			if got := entries.Messages(); !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("recorded %q, want %q", got, test.want)
+			}
+
+			if len(entries) > 0 && entries[0].Level != test.level {
+				t.Errorf("recorded at %v, want %v", entries[0].Level, test.level)
+			}
+		})
+	}
+}
+
+func TestHookOrderAndLevels(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := hqgologgerloggertest.New(t)
+
+	var calls []string
+
+	hook := func(name string, levels ...hqgologgerlevels.Level) (hook *hqgologger.Hook) {
+		hook = hqgologger.NewHook(&hqgologger.HookConfiguration{
+			Levels: levels,
+			BeforeFormat: func(event *hqgologger.Event) (keep bool) {
+				calls = append(calls, name+" before "+event.Message())
+
+				keep = true
+
+				return
+			},
+			AfterWrite: func(event *hqgologger.Event, _ error) {
+				calls = append(calls, name+" after "+event.Message())
+			},
+		})
+
+		return
+	}
+
+	logger.AddHook(hook("first"), hook("errors only", hqgologgerlevels.LevelError))
+	logger.AddHook(hook("second"))
+
+	logger.Info("info")
+	logger.Error("error")
+
+	want := []string{
+		"first before info",
+		"second before info",
+		"first after info",
+		"second after info",
+		"first before error",
+		"errors only before error",
+		"second before error",
+		"first after error",
+		"errors only after error",
+		"second after error",
+	}
+
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls =\n%q\nwant\n%q", calls, want)
+	}
+}
+
+// failingWriter is a Writer whose writes fail.
+type failingWriter struct {
+	err error
+}
+
+func (w *failingWriter) Write([]byte, hqgologgerlevels.Level) (err error) {
+	err = w.err
+
+	return
+}
+
+func (w *failingWriter) Close() (err error) {
+	return
+}
+
+func TestHookAfterWriteError(t *testing.T) {
+	t.Parallel()
+
+	writeErr := errors.New("disk full")
+
+	logger := newDiscardLogger(hqgologgerlevels.LevelInfo)
+
+	logger.SetWriter(&failingWriter{err: writeErr})
+
+	var got error
+
+	logger.AddHook(hqgologger.NewHook(&hqgologger.HookConfiguration{
+		AfterWrite: func(_ *hqgologger.Event, err error) {
+			got = err
+		},
+	}))
+
+	logger.Info("lost")
+
+	if !errors.Is(got, writeErr) {
+		t.Errorf("AfterWrite error = %v, want %v", got, writeErr)
+	}
+}
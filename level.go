@@ -0,0 +1,240 @@
+package logger
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// AtomicLevel is a level threshold that can be read without locking and changed at
+// runtime, e.g., to turn on debug output in a long-running service without a restart.
+// It can be shared by several loggers (see Logger.SetAtomicLevel), so that changing it
+// affects all of them. Besides the current level, it remembers the configured level
+// last set with SetLevel, which ToggleDebug restores. It also serves as an
+// http.Handler reading and changing the level (see ServeHTTP). An AtomicLevel is safe
+// for concurrent use.
+//
+// Fields:
+//   - mutex (*sync.Mutex): Serializes changes of the level.
+//   - level (atomic.Int64): The current level.
+//   - configured (hqgologgerlevels.Level): The level last set with SetLevel.
+//   - toggled (bool): Whether ToggleDebug raised the current level to LevelDebug.
+type AtomicLevel struct {
+	mutex      *sync.Mutex
+	level      atomic.Int64
+	configured hqgologgerlevels.Level
+	toggled    bool
+}
+
+// Level returns the current level.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The current level.
+func (a *AtomicLevel) Level() (level hqgologgerlevels.Level) {
+	level = hqgologgerlevels.Level(a.level.Load())
+
+	return
+}
+
+// SetLevel sets the current and configured level, ending any debug toggle (see
+// ToggleDebug).
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The level to set.
+func (a *AtomicLevel) SetLevel(level hqgologgerlevels.Level) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.configured = level
+	a.toggled = false
+
+	a.level.Store(int64(level))
+}
+
+// Enabled reports whether events at the given level are within the threshold.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level to check.
+//
+// Returns:
+//   - enabled (bool): True if events at the level pass the threshold.
+func (a *AtomicLevel) Enabled(level hqgologgerlevels.Level) (enabled bool) {
+	enabled = level <= a.Level()
+
+	return
+}
+
+// ToggleDebug raises the current level to LevelDebug if it is less verbose, or, if a
+// previous call raised it, restores the configured level. A current level of LevelDebug
+// or more verbose (e.g., LevelTrace) that ToggleDebug did not set is left unchanged, so
+// toggling never lowers verbosity. The configured level is left unchanged.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The new current level.
+func (a *AtomicLevel) ToggleDebug() (level hqgologgerlevels.Level) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	level = a.Level()
+
+	switch {
+	case a.toggled:
+		level = a.configured
+
+		a.toggled = false
+	case level < hqgologgerlevels.LevelDebug:
+		level = hqgologgerlevels.LevelDebug
+
+		a.toggled = true
+	}
+
+	a.level.Store(int64(level))
+
+	return
+}
+
+// ToggleDebugOn starts a goroutine that calls ToggleDebug whenever one of the given
+// signals (e.g., syscall.SIGUSR1) is received. The returned function stops listening
+// for the signals and terminates the goroutine.
+//
+// Parameters:
+//   - signals (...os.Signal): The signals that toggle debug output.
+//
+// Returns:
+//   - stop (func()): A function that stops toggling on the signals.
+func (a *AtomicLevel) ToggleDebugOn(signals ...os.Signal) (stop func()) {
+	ch := make(chan os.Signal, 1)
+	done := make(chan struct{})
+
+	signal.Notify(ch, signals...)
+
+	go func() {
+		for {
+			select {
+			case <-ch:
+				a.ToggleDebug()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	stop = func() {
+		once.Do(func() {
+			signal.Stop(ch)
+			close(done)
+		})
+	}
+
+	return
+}
+
+// ServeHTTP reads or changes the level over HTTP, as JSON objects of the form
+// {"level":"info"}. A GET request returns the current level. A PUT request sets the
+// current and configured level from the request body, in which the level name is
+// case-insensitive (e.g., "INFO"), and returns the new level. Other
+// methods are rejected with 405 Method Not Allowed, and malformed bodies or unknown
+// levels with 400 Bad Request and a body of the form {"error":"..."}.
+//
+// Parameters:
+//   - w (http.ResponseWriter): The response writer.
+//   - r (*http.Request): The request.
+func (a *AtomicLevel) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var payload _LevelRequestPayload
+
+		var level hqgologgerlevels.Level
+
+		err := json.NewDecoder(r.Body).Decode(&payload)
+
+		switch {
+		case err != nil:
+		case payload.Level == nil:
+			err = _ErrMissingLevel
+		default:
+			err = level.UnmarshalText([]byte(strings.ToLower(strings.TrimSpace(*payload.Level))))
+		}
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			_ = json.NewEncoder(w).Encode(_LevelErrorPayload{Error: err.Error()})
+
+			return
+		}
+
+		a.SetLevel(level)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		_ = json.NewEncoder(w).Encode(_LevelErrorPayload{Error: "only GET and PUT are supported"})
+
+		return
+	}
+
+	level := a.Level()
+
+	_ = json.NewEncoder(w).Encode(_LevelPayload{Level: &level})
+}
+
+// _LevelPayload is the JSON body of level responses.
+//
+// Fields:
+//   - Level (*hqgologgerlevels.Level): The level.
+type _LevelPayload struct {
+	Level *hqgologgerlevels.Level `json:"level"`
+}
+
+// _LevelRequestPayload is the JSON body of level requests. The level is read as a
+// string, so that its name can be matched case-insensitively.
+//
+// Fields:
+//   - Level (*string): The name of the level, nil if absent.
+type _LevelRequestPayload struct {
+	Level *string `json:"level"`
+}
+
+// _LevelErrorPayload is the JSON body of failed level requests.
+//
+// Fields:
+//   - Error (string): The reason of the failure.
+type _LevelErrorPayload struct {
+	Error string `json:"error"`
+}
+
+// _ErrMissingLevel is reported to clients of ServeHTTP whose request has no level.
+var _ErrMissingLevel = errors.New("missing level")
+
+var _ http.Handler = (*AtomicLevel)(nil)
+
+// NewAtomicLevel creates and returns a new AtomicLevel set to the given level.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The initial level.
+//
+// Returns:
+//   - atomicLevel (*AtomicLevel): A pointer to a new AtomicLevel instance.
+func NewAtomicLevel(level hqgologgerlevels.Level) (atomicLevel *AtomicLevel) {
+	atomicLevel = &AtomicLevel{
+		mutex:      &sync.Mutex{},
+		configured: level,
+	}
+
+	atomicLevel.level.Store(int64(level))
+
+	return
+}
@@ -0,0 +1,164 @@
+package logger_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+func TestAtomicLevelToggleDebug(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		configured hqgologgerlevels.Level
+		want       []hqgologgerlevels.Level
+	}{
+		{
+			name:       "less verbose than debug",
+			configured: hqgologgerlevels.LevelInfo,
+			want:       []hqgologgerlevels.Level{hqgologgerlevels.LevelDebug, hqgologgerlevels.LevelInfo, hqgologgerlevels.LevelDebug},
+		},
+		{
+			name:       "silent",
+			configured: hqgologgerlevels.LevelSilent,
+			want:       []hqgologgerlevels.Level{hqgologgerlevels.LevelDebug, hqgologgerlevels.LevelSilent},
+		},
+		{
+			name:       "debug",
+			configured: hqgologgerlevels.LevelDebug,
+			want:       []hqgologgerlevels.Level{hqgologgerlevels.LevelDebug, hqgologgerlevels.LevelDebug},
+		},
+		{
+			name:       "trace",
+			configured: hqgologgerlevels.LevelTrace,
+			want:       []hqgologgerlevels.Level{hqgologgerlevels.LevelTrace, hqgologgerlevels.LevelTrace},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			level := hqgologger.NewAtomicLevel(test.configured)
+
+			for i, want := range test.want {
+				if got := level.ToggleDebug(); got != want {
+					t.Fatalf("ToggleDebug() #%d = %v, want %v", i+1, got, want)
+				}
+
+				if got := level.Level(); got != want {
+					t.Fatalf("Level() after ToggleDebug() #%d = %v, want %v", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAtomicLevelSetLevelEndsToggle(t *testing.T) {
+	t.Parallel()
+
+	level := hqgologger.NewAtomicLevel(hqgologgerlevels.LevelInfo)
+
+	level.ToggleDebug()
+	level.SetLevel(hqgologgerlevels.LevelWarn)
+
+	if got := level.ToggleDebug(); got != hqgologgerlevels.LevelDebug {
+		t.Fatalf("ToggleDebug() = %v, want %v", got, hqgologgerlevels.LevelDebug)
+	}
+
+	if got := level.ToggleDebug(); got != hqgologgerlevels.LevelWarn {
+		t.Fatalf("ToggleDebug() = %v, want %v", got, hqgologgerlevels.LevelWarn)
+	}
+}
+
+func TestAtomicLevelServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		status int
+		want   hqgologgerlevels.Level
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			want:   hqgologgerlevels.LevelWarn,
+		},
+		{
+			name:   "put",
+			method: http.MethodPut,
+			body:   `{"level":"debug"}`,
+			status: http.StatusOK,
+			want:   hqgologgerlevels.LevelDebug,
+		},
+		{
+			name:   "put upper case",
+			method: http.MethodPut,
+			body:   `{"level":"INFO"}`,
+			status: http.StatusOK,
+			want:   hqgologgerlevels.LevelInfo,
+		},
+		{
+			name:   "put unknown level",
+			method: http.MethodPut,
+			body:   `{"level":"verbose"}`,
+			status: http.StatusBadRequest,
+			want:   hqgologgerlevels.LevelWarn,
+		},
+		{
+			name:   "put missing level",
+			method: http.MethodPut,
+			body:   `{}`,
+			status: http.StatusBadRequest,
+			want:   hqgologgerlevels.LevelWarn,
+		},
+		{
+			name:   "put malformed body",
+			method: http.MethodPut,
+			body:   `{"level":`,
+			status: http.StatusBadRequest,
+			want:   hqgologgerlevels.LevelWarn,
+		},
+		{
+			name:   "post",
+			method: http.MethodPost,
+			body:   `{"level":"debug"}`,
+			status: http.StatusMethodNotAllowed,
+			want:   hqgologgerlevels.LevelWarn,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			level := hqgologger.NewAtomicLevel(hqgologgerlevels.LevelWarn)
+
+			recorder := httptest.NewRecorder()
+
+			level.ServeHTTP(recorder, httptest.NewRequest(test.method, "/level", strings.NewReader(test.body)))
+
+			if recorder.Code != test.status {
+				t.Errorf("status = %d, want %d (body %s)", recorder.Code, test.status, recorder.Body)
+			}
+
+			if got := level.Level(); got != test.want {
+				t.Errorf("Level() = %v, want %v", got, test.want)
+			}
+
+			if test.status == http.StatusOK {
+				if want := `{"level":"` + test.want.String() + `"}` + "\n"; recorder.Body.String() != want {
+					t.Errorf("body = %q, want %q", recorder.Body, want)
+				}
+			}
+		})
+	}
+}
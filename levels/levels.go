@@ -8,7 +8,14 @@ import (
 // Level represents the severity of a log message. It is an integer-based type
 // used throughout the logging system to indicate the importance or criticality
 // of a message. The defined levels, in order of increasing verbosity, are:
-// LevelFatal, LevelSilent, LevelError, LevelInfo, LevelWarn, and LevelDebug.
+// LevelFatal, LevelSilent, LevelError, LevelWarn, LevelInfo, LevelDebug, and LevelTrace.
+// A logger with a given level threshold logs the messages of that level and of all the
+// less verbose ones.
+//
+// Before LevelTrace was added, LevelInfo came before LevelWarn, so that a threshold of
+// LevelInfo hid warnings. The text representations of levels (see MarshalText) did not
+// change, but their integer values did; integer values stored with the previous
+// numbering can be converted with FromLegacyInt and LegacyInt.
 type Level int
 
 // MarshalText implements the encoding.TextMarshaler interface to convert a Level
@@ -27,6 +34,8 @@ func (l Level) MarshalText() (bytes []byte, err error) {
 // UnmarshalText implements the encoding.TextUnmarshaler interface to parse a
 // text representation (e.g., from JSON or YAML) into a Level. It matches the input
 // text against known level strings in the s array and sets the Level accordingly.
+// As level names are unaffected by the reordering of LevelWarn and LevelInfo, text
+// serialized with either numbering parses to the same level.
 //
 // Parameters:
 //   - text ([]byte): The text representation of the Level to parse.
@@ -45,13 +54,15 @@ func (l *Level) UnmarshalText(text []byte) (err error) {
 		}
 	}
 
-	err = fmt.Errorf("%w (%s)", ErrUnknownLevel, s)
+	err = fmt.Errorf("%w (%s)", ErrUnknownLevel, str)
 
 	return
 }
 
 // Int returns the integer value of the Level, allowing direct access to its
-// underlying numeric representation for comparisons or indexing.
+// underlying numeric representation for comparisons or indexing. Integer values grow
+// with verbosity; LevelWarn is 3 and LevelInfo is 4 (see LegacyInt for the previous
+// numbering).
 //
 // Returns:
 //   - level (int): The integer value of the Level.
@@ -61,6 +72,28 @@ func (l Level) Int() (level int) {
 	return
 }
 
+// LegacyInt returns the integer value of the Level in the numbering used before
+// LevelWarn and LevelInfo were reordered, in which LevelInfo is 3 and LevelWarn is 4,
+// for interoperating with code and data that use it. LevelTrace, which the previous
+// numbering lacks, is returned as LevelDebug (5). Invalid levels are returned unchanged.
+//
+// Returns:
+//   - level (int): The integer value of the Level in the previous numbering.
+func (l Level) LegacyInt() (level int) {
+	switch l {
+	case LevelWarn:
+		level = 4
+	case LevelInfo:
+		level = 3
+	case LevelTrace:
+		level = 5
+	default:
+		level = l.Int()
+	}
+
+	return
+}
+
 // String returns the string representation of the Level, mapping its integer value
 // to a lowercase label for use in log output or display. If the Level's integer
 // value is out of range (i.e., less than 0 or greater than or equal to the length
@@ -105,29 +138,57 @@ const (
 	// program execution. Examples include failed API calls, invalid user input, or
 	// resource unavailability.
 	LevelError
-	// LevelInfo captures informational messages about normal application operation,
-	// such as successful initialization, user actions, or system state changes.
-	LevelInfo
 	// LevelWarn denotes warnings for potential issues or unexpected conditions that
 	// do not prevent normal operation but may warrant investigation. Examples include
 	// deprecated API usage or resource usage nearing limits.
 	LevelWarn
+	// LevelInfo captures informational messages about normal application operation,
+	// such as successful initialization, user actions, or system state changes.
+	LevelInfo
 	// LevelDebug provides detailed context for troubleshooting and development. Use
-	// this for verbose output, such as variable states, function call traces, or
-	// detailed system diagnostics, typically enabled in development or debugging
-	// environments.
+	// this for verbose output, such as variable states or detailed system diagnostics,
+	// typically enabled in development or debugging environments.
 	LevelDebug
+	// LevelTrace provides the most verbose output, such as function call traces or
+	// the contents of every request, usually enabled only for a single component while
+	// chasing a specific problem.
+	LevelTrace
 )
 
 // s maps Level values to their string representations. It is used by the String()
 // method to convert a Level to its corresponding lowercase label. The array is
-// indexed by the integer value of the Level, with indices 0 to 5 corresponding to
-// LevelFatal through LevelDebug. Out-of-range indices are handled safely by String()
+// indexed by the integer value of the Level, with indices 0 to 6 corresponding to
+// LevelFatal through LevelTrace. Out-of-range indices are handled safely by String()
 // to return "unknown".
-var s = [...]string{"fatal", "silent", "error", "info", "warn", "debug"}
+var s = [...]string{"fatal", "silent", "error", "warn", "info", "debug", "trace"}
 
 var (
 	// ErrUnknownLevel is an error returned when an invalid or unrecognized level string
 	// is provided during unmarshaling or other operations that require a valid Level.
 	ErrUnknownLevel = errors.New("unknown level")
 )
+
+// FromLegacyInt converts an integer value in the numbering used before LevelWarn and
+// LevelInfo were reordered, in which LevelInfo is 3 and LevelWarn is 4, to a Level.
+// It is intended for levels stored as integers (e.g., with Int) by earlier versions.
+// Values outside of the previous numbering are converted to invalid levels.
+//
+// Parameters:
+//   - level (int): The integer value in the previous numbering.
+//
+// Returns:
+//   - converted (Level): The corresponding Level.
+func FromLegacyInt(level int) (converted Level) {
+	switch level {
+	case 3:
+		converted = LevelInfo
+	case 4:
+		converted = LevelWarn
+	case 0, 1, 2, 5:
+		converted = Level(level)
+	default:
+		converted = Level(-1)
+	}
+
+	return
+}
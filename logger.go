@@ -1,9 +1,13 @@
 package logger
 
 import (
+	"bytes"
+	"context"
 	"os"
+	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
@@ -11,25 +15,106 @@ import (
 	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
 )
 
-// _Event represents a log event with a severity level, message, timestamp, and optional metadata.
-// It is used internally by the Logger to construct log messages before formatting and writing.
-// The event is built using the options pattern, allowing flexible configuration of its fields
-// via OptionFunc functions. The timestamp is set by formatters or writers if not explicitly
-// configured.
+// Event represents a log event with a severity level, message, timestamp, and optional metadata.
+// It is used by the Logger to construct log messages before formatting and writing. The event
+// is built using the options pattern: an OptionFunc receives the event and configures it
+// through its mutators (e.g., SetString, SetError), which lets packages write their own
+// options setting several fields at once. Its accessors (e.g., Level, Value) let options and
+// hooks inspect it. Events can also be created with NewEvent and submitted with Logger.Log.
+//
+// Events passed to options by the level-specific logging methods and the EventBuilder are
+// reused once logged, so options must not retain them.
 //
 // Fields:
 //   - timestamp (time.Time): The time the log event was created, used for timestamped output.
 //   - level (hqgologgerlevels.Level): The severity level of the log message, as defined in the levels
 //     package (e.g., LevelInfo, LevelFatal). Lower values indicate higher severity.
 //   - message (string): The primary content of the log message, describing the event or condition.
-//   - metadata (map[string]any): Optional key-value pairs for additional context, such
-//     as labels, errors, or system metrics. The "label" key is used for formatted output, and
-//     the "error" key is used for error details.
-type _Event struct {
+//   - metadata (hqgologgerformatter.Fields): Optional key-value pairs for additional context,
+//     such as labels, errors, or system metrics, in the order they were set. The "label" key
+//     is used for formatted output, and the "error" key is used for error details.
+//   - pc (uintptr): The program counter of the code that produced the event, resolved into
+//     the caller passed to formatters. Zero if not yet captured.
+//   - exitCode (int): The status code to exit with after a LevelFatal event, overriding
+//     the logger's exit code if non-zero.
+//   - spare (hqgologgerformatter.Fields): A second metadata buffer used when merging bound
+//     and context metadata, swapped with metadata so both can be reused.
+//   - log (hqgologgerformatter.Log): The log passed to formatters, embedded to avoid
+//     allocating it separately.
+type Event struct {
 	timestamp time.Time
 	level     hqgologgerlevels.Level
 	message   string
-	metadata  map[string]any
+	metadata  hqgologgerformatter.Fields
+	pc        uintptr
+	exitCode  int
+	spare     hqgologgerformatter.Fields
+	log       hqgologgerformatter.Log
+}
+
+// Timestamp returns the timestamp of the log event.
+//
+// Returns:
+//   - t (time.Time): The timestamp, or the zero time if the event has none.
+func (e *Event) Timestamp() (t time.Time) {
+	t = e.timestamp
+
+	return
+}
+
+// Level returns the severity level of the log event.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The severity level.
+func (e *Event) Level() (level hqgologgerlevels.Level) {
+	level = e.level
+
+	return
+}
+
+// Message returns the message of the log event.
+//
+// Returns:
+//   - message (string): The log message.
+func (e *Event) Message() (message string) {
+	message = e.message
+
+	return
+}
+
+// Value returns the metadata value stored under the given key.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//
+// Returns:
+//   - value (any): The stored value, or nil if the key is not set.
+//   - ok (bool): True if the key is set.
+func (e *Event) Value(key string) (value any, ok bool) {
+	value, ok = e.metadata.Get(key)
+
+	return
+}
+
+// Metadata returns a copy of the log event's metadata, in the order it was set.
+//
+// Returns:
+//   - metadata (hqgologgerformatter.Fields): A copy of the metadata.
+func (e *Event) Metadata() (metadata hqgologgerformatter.Fields) {
+	metadata = append(hqgologgerformatter.Fields(nil), e.metadata...)
+
+	return
+}
+
+// ExitCode returns the status code to exit with after a LevelFatal event, or zero if
+// the event does not override the logger's exit code.
+//
+// Returns:
+//   - code (int): The exit status code.
+func (e *Event) ExitCode() (code int) {
+	code = e.exitCode
+
+	return
 }
 
 // SetTimestamp sets the timestamp of the log event, used for including timing information
@@ -37,7 +122,7 @@ type _Event struct {
 //
 // Parameters:
 //   - t (time.Time): The timestamp to set for the log event.
-func (e *_Event) SetTimestamp(t time.Time) {
+func (e *Event) SetTimestamp(t time.Time) {
 	e.timestamp = t
 }
 
@@ -46,7 +131,7 @@ func (e *_Event) SetTimestamp(t time.Time) {
 //
 // Parameters:
 //   - l (hqgologgerlevels.Level): The severity level to set, from the levels package (e.g., LevelFatal).
-func (e *_Event) SetLevel(l hqgologgerlevels.Level) {
+func (e *Event) SetLevel(l hqgologgerlevels.Level) {
 	e.level = l
 }
 
@@ -55,37 +140,77 @@ func (e *_Event) SetLevel(l hqgologgerlevels.Level) {
 //
 // Parameters:
 //   - m (string): The log message to set.
-func (e *_Event) SetMessage(m string) {
+func (e *Event) SetMessage(m string) {
 	e.message = m
 }
 
 // SetValue adds a key-value pair to the log event's metadata with a value of any type.
-// If the metadata map is nil, it is initialized before adding the pair. This allows
-// flexibility for storing various data types, such as integers or errors, in metadata.
+// If the key is already set, its value is replaced and it keeps its position; otherwise
+// the pair is appended, so metadata preserves the order in which keys were first set.
+// This allows flexibility for storing various data types, such as integers or errors,
+// in metadata.
 //
 // Parameters:
 //   - key (string): The metadata key.
 //   - value (any): The metadata value, which can be any type.
-func (e *_Event) SetValue(key string, value any) {
-	if e.metadata == nil {
-		e.metadata = make(map[string]any)
-	}
-
-	e.metadata[key] = value
+func (e *Event) SetValue(key string, value any) {
+	e.metadata.Set(key, value)
 }
 
-// SetString adds a key-value pair to the log event's metadata with a string value. If the
-// metadata map is nil, it is initialized before adding the pair.
+// SetString adds a key-value pair to the log event's metadata with a string value,
+// following the same ordering rules as SetValue.
 //
 // Parameters:
 //   - key (string): The metadata key.
 //   - value (string): The metadata value.
-func (e *_Event) SetString(key, value string) {
-	if e.metadata == nil {
-		e.metadata = make(map[string]any)
+func (e *Event) SetString(key, value string) {
+	e.metadata.SetField(hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindString, String: value})
+}
+
+// SetField adds a typed metadata field to the log event, following the same ordering
+// rules as SetValue. It is used by the typed field constructors (e.g., Int, Duration),
+// which store values without boxing them in an interface.
+//
+// Parameters:
+//   - field (hqgologgerformatter.Field): The field to add.
+func (e *Event) SetField(field hqgologgerformatter.Field) {
+	e.metadata.SetField(field)
+}
+
+// mergeMetadata places the base metadata (e.g., metadata bound with With) before the
+// event's own metadata, for keys the event does not set, so that base keys come first
+// and the event's own values take precedence. The base metadata is not modified.
+//
+// Parameters:
+//   - base (hqgologgerformatter.Fields): The metadata to add.
+func (e *Event) mergeMetadata(base hqgologgerformatter.Fields) {
+	if len(base) == 0 {
+		return
+	}
+
+	merged := e.spare[:0]
+
+	for _, field := range base {
+		if _, ok := e.metadata.Lookup(field.Key); !ok {
+			merged = append(merged, field)
+		}
 	}
 
-	e.metadata[key] = value
+	merged = append(merged, e.metadata...)
+
+	e.metadata, e.spare = merged, e.metadata
+}
+
+// reset clears the event so that it can be reused from _EventPool, keeping the
+// capacity of its metadata buffers.
+func (e *Event) reset() {
+	clear(e.metadata)
+	clear(e.spare)
+
+	*e = Event{
+		metadata: e.metadata[:0],
+		spare:    e.spare[:0],
+	}
 }
 
 // SetLabel sets the "label" metadata field for the log event, typically used by formatters
@@ -94,17 +219,35 @@ func (e *_Event) SetString(key, value string) {
 //
 // Parameters:
 //   - label (string): The label to set in the metadata.
-func (e *_Event) SetLabel(label string) {
+func (e *Event) SetLabel(label string) {
 	e.SetString("label", label)
 }
 
+// Delete removes the given key from the log event's metadata, keeping the order of the
+// remaining keys. Deleting a key that is not set is a no-op.
+//
+// Parameters:
+//   - key (string): The metadata key.
+func (e *Event) Delete(key string) {
+	e.metadata.Delete(key)
+}
+
+// SetExitCode sets the status code to exit with after a LevelFatal event, overriding the
+// logger's exit code if non-zero.
+//
+// Parameters:
+//   - code (int): The exit status code.
+func (e *Event) SetExitCode(code int) {
+	e.exitCode = code
+}
+
 // SetError adds an error to the log event's metadata under the "error" key. The error is
 // stored as-is, and formatters are responsible for converting it to a string or other format
 // (e.g., including stack traces). This is a convenience method that delegates to SetValue.
 //
 // Parameters:
 //   - err (error): The error to set in the metadata.
-func (e *_Event) SetError(err error) {
+func (e *Event) SetError(err error) {
 	e.SetValue("error", err)
 }
 
@@ -113,37 +256,110 @@ func (e *_Event) SetError(err error) {
 // uses a formatter to convert events to byte slices, and delegates output to a writer. The
 // Logger is thread-safe, using a read-write mutex to protect configuration changes while
 // allowing concurrent logging. It provides level-specific methods (e.g., Info, Fatal) for
-// convenient logging and supports metadata via the options pattern.
+// convenient logging and supports metadata via the options pattern. Derived loggers created
+// with With share the configuration of their parent and carry additional bound metadata,
+// and those created with Named carry a name identifying a component.
+//
+// Fields:
+//   - core (*_Core): The configuration (level, formatter, writer) shared between a logger
+//     and every logger derived from it.
+//   - metadata (hqgologgerformatter.Fields): Metadata bound to this logger with With, added
+//     to every event it logs. The slice is never modified after the logger is created.
+//   - callerSkip (int): The number of additional stack frames to skip when capturing the
+//     caller, set with AddCallerSkip for loggers used from wrapper functions.
+//   - name (string): The dot-separated name of the logger, set with Named, or empty.
+type Logger struct {
+	core       *_Core
+	metadata   hqgologgerformatter.Fields
+	callerSkip int
+	name       string
+}
+
+// _Core holds the configuration shared by a Logger and all loggers derived from it with
+// With. Changing the level, formatter, or writer through any of them affects all of them.
 //
 // Fields:
-//   - mutex (*sync.RWMutex): Ensures thread-safe access to configuration fields (level,
-//     formatter, writer) during updates and concurrent logging.
-//   - level (hqgologgerlevels.Level): The minimum severity level for logging (inclusive). Messages
+//   - mutex (*sync.RWMutex): Ensures thread-safe access to configuration fields (formatter,
+//     writer) during updates and concurrent logging.
+//   - level (atomic.Pointer[AtomicLevel]): The minimum severity level for logging
+//     (inclusive), read without locking and possibly shared with other loggers. Messages
 //     with a higher level value (less severe) are ignored. Lower values indicate higher
 //     severity (e.g., LevelFatal = 0, LevelDebug = 5).
 //   - formatter (hqgologgerformatter.Formatter): The formatter to convert log events to byte slices
 //     for output (e.g., JSON or plain text).
 //   - writer (hqgologgerwriter.Writer): The writer to output formatted log data to destinations
 //     like files or consoles.
-type Logger struct {
+//   - caller (bool): If true, the source location of each logged event is captured and
+//     passed to the formatter.
+//   - exit (func(code int)): The function called to terminate the program after a
+//     LevelFatal event, os.Exit by default.
+//   - exitCode (int): The status code passed to exit after a LevelFatal event, 1 by default.
+//   - exitHooks ([]func()): Functions run, in registration order, after a LevelFatal event
+//     is written and before the writer is closed and the program exits.
+//   - sinks ([]*Sink): Additional output pipelines, each with its own formatter, writer,
+//     and level, that every event is passed to alongside the formatter and writer above.
+//   - hooks ([]*Hook): Hooks run, in registration order, before each event is formatted
+//     and after it is written.
+//   - redactor (*Redactor): Masks sensitive data in each event before it is formatted,
+//     if set.
+//   - sampler (*Sampler): Drops repetitive events, if set.
+//   - deduplicator (*Deduplicator): Collapses consecutive identical events, if set.
+//   - clock (Clock): Provides the timestamps of the events created by the logger.
+//   - rules (atomic.Pointer[LevelRules]): Override the level threshold of named loggers,
+//     if set, read without locking.
+type _Core struct {
 	mutex     *sync.RWMutex
-	level     hqgologgerlevels.Level
+	level     atomic.Pointer[AtomicLevel]
 	formatter hqgologgerformatter.Formatter
 	writer    hqgologgerwriter.Writer
+	caller    bool
+	exit      func(code int)
+	exitCode  int
+	exitHooks []func()
+	sinks     []*Sink
+	hooks     []*Hook
+	redactor  *Redactor
+	sampler   *Sampler
+	dedup     *Deduplicator
+	clock     Clock
+	rules     atomic.Pointer[LevelRules]
 }
 
 // SetLevel sets the minimum severity level for logging. Messages with a level greater
-// than the specified level (less severe) are ignored. The method is thread-safe, using
-// a mutex to protect the level field. The levels package uses lower values for higher
-// severity (e.g., LevelFatal = 0, LevelDebug = 5).
+// than the specified level (less severe) are ignored. The level is set on the logger's
+// AtomicLevel, so it also applies to the loggers sharing it. The levels package uses
+// lower values for higher severity (e.g., LevelFatal = 0, LevelDebug = 5).
 //
 // Parameters:
 //   - level (hqgologgerlevels.Level): The minimum severity level to log.
 func (l *Logger) SetLevel(level hqgologgerlevels.Level) {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
+	l.core.level.Load().SetLevel(level)
+}
+
+// AtomicLevel returns the level of the logger, which can be changed at runtime, e.g.,
+// over HTTP (see AtomicLevel.ServeHTTP), or shared with other loggers with
+// SetAtomicLevel.
+//
+// Returns:
+//   - level (*AtomicLevel): The level of the logger.
+func (l *Logger) AtomicLevel() (level *AtomicLevel) {
+	level = l.core.level.Load()
+
+	return
+}
 
-	l.level = level
+// SetAtomicLevel makes the logger use the given level, so that changing it affects all
+// the loggers using it. If level is nil, the logger gets a level of its own, set to its
+// current level.
+//
+// Parameters:
+//   - level (*AtomicLevel): The level to use.
+func (l *Logger) SetAtomicLevel(level *AtomicLevel) {
+	if level == nil {
+		level = NewAtomicLevel(l.core.level.Load().Level())
+	}
+
+	l.core.level.Store(level)
 }
 
 // SetFormatter sets the formatter used to convert log events to byte slices. The method
@@ -153,10 +369,10 @@ func (l *Logger) SetLevel(level hqgologgerlevels.Level) {
 // Parameters:
 //   - f (hqgologgerformatter.Formatter): The formatter to use for log events.
 func (l *Logger) SetFormatter(f hqgologgerformatter.Formatter) {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
 
-	l.formatter = f
+	l.core.formatter = f
 }
 
 // SetWriter sets the writer used to output formatted log data to a destination (e.g.,
@@ -165,25 +381,426 @@ func (l *Logger) SetFormatter(f hqgologgerformatter.Formatter) {
 // Parameters:
 //   - w (hqgologgerwriter.Writer): The writer to use for log output.
 func (l *Logger) SetWriter(w hqgologgerwriter.Writer) {
-	l.mutex.Lock()
-	defer l.mutex.Unlock()
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	l.core.writer = w
+}
+
+// SetExitFunc sets the function called to terminate the program after a LevelFatal event
+// is written, replacing os.Exit. It is mainly useful in tests, where exiting the process
+// is undesirable. A nil function restores os.Exit. The method is thread-safe.
+//
+// Parameters:
+//   - exit (func(code int)): The function to call with the exit status code.
+func (l *Logger) SetExitFunc(exit func(code int)) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	if exit == nil {
+		exit = os.Exit
+	}
+
+	l.core.exit = exit
+}
+
+// SetExitCode sets the status code the program exits with after a LevelFatal event,
+// 1 by default. A single event can override it with WithExitCode. The method is
+// thread-safe.
+//
+// Parameters:
+//   - code (int): The exit status code.
+func (l *Logger) SetExitCode(code int) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	l.core.exitCode = code
+}
+
+// AddExitHook registers a function to run after a LevelFatal event is written and
+// before the writer is closed and the program exits, for example to release resources
+// or report the failure elsewhere. Hooks run in registration order and may still log
+// through the logger. The method is thread-safe.
+//
+// Parameters:
+//   - hook (func()): The function to run before exiting.
+func (l *Logger) AddExitHook(hook func()) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	l.core.exitHooks = append(l.core.exitHooks, hook)
+}
+
+// AddSink adds output pipelines that every event is passed to, in addition to the
+// logger's own formatter and writer. Each sink formats the event independently. Events
+// must pass the logger's level (see SetLevel) before they reach the sinks, and each sink
+// then applies its own level, which can only restrict it further. A sink failing to
+// format or write an event does not prevent the other sinks from receiving it. Loggers
+// used only through sinks need no formatter or writer of their own. The method is
+// thread-safe.
+//
+// Parameters:
+//   - sinks (...*Sink): The sinks to add. Nil sinks are ignored.
+func (l *Logger) AddSink(sinks ...*Sink) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	for _, sink := range sinks {
+		if sink != nil {
+			l.core.sinks = append(l.core.sinks, sink)
+		}
+	}
+}
+
+// AddHook adds hooks run for every event at the levels they are scoped to. Hooks run in
+// registration order, after the event passes the level check and bound metadata is
+// added, and before the default label is added and the event is formatted, so they can
+// add or remove metadata, set the label, change the level, or veto the event. The event
+// is then checked again against the level thresholds at its possibly changed level.
+// After the event is written by the logger and its sinks, the hooks run again for their
+// side effects. Hooks only see events accepted at their original level, and changing the
+// level or vetoing a LevelFatal event does not prevent the program from exiting. Hooks
+// must not log through the logger at the levels they are scoped to, which would recurse.
+// The method is thread-safe.
+//
+// Parameters:
+//   - hooks (...*Hook): The hooks to add. Nil hooks are ignored.
+func (l *Logger) AddHook(hooks ...*Hook) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	for _, hook := range hooks {
+		if hook != nil {
+			l.core.hooks = append(l.core.hooks, hook)
+		}
+	}
+}
+
+// SetRedactor sets the Redactor masking sensitive data (e.g., passwords, tokens, or
+// values wrapped in Sensitive) in the message and metadata of every event. Redaction
+// runs after the hooks and before the event is formatted, so neither the formatter nor
+// the sinks nor the hooks run after writing see the original values. A nil redactor
+// disables redaction, which is the default. The method is thread-safe.
+//
+// Parameters:
+//   - redactor (*Redactor): The redactor to use, or nil.
+func (l *Logger) SetRedactor(redactor *Redactor) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	l.core.redactor = redactor
+}
+
+// SetSampler sets the Sampler limiting the volume of repetitive events, such as
+// identical messages logged in hot loops. Events are sampled after the level check and
+// before any other processing, so dropped events are neither passed to the hooks nor
+// written by the sinks. A nil sampler disables sampling, which is the default. The
+// method is thread-safe.
+//
+// Parameters:
+//   - sampler (*Sampler): The sampler to use, or nil.
+func (l *Logger) SetSampler(sampler *Sampler) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	l.core.sampler = sampler
+}
+
+// SetDeduplicator sets the Deduplicator collapsing runs of consecutive identical events
+// into a single "last message repeated N times" (or "once") event. Events are compared
+// after sampling and after the bound metadata is added, and collapsed events are
+// neither passed to the hooks nor written by the sinks. A nil deduplicator disables
+// deduplication, which is the default. The method is thread-safe.
+//
+// Parameters:
+//   - deduplicator (*Deduplicator): The deduplicator to use, or nil.
+func (l *Logger) SetDeduplicator(deduplicator *Deduplicator) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	l.core.dedup = deduplicator
+}
+
+// SetClock sets the Clock providing the timestamps of the events created by the logger,
+// through its logging methods, its EventBuilders, and the standard library log adapter,
+// as well as the time used for sampling. Events created with NewEvent and records of the
+// log/slog adapter carry their own timestamps. A nil clock restores the system clock,
+// which is the default. The method is thread-safe.
+//
+// Parameters:
+//   - clock (Clock): The clock to use, or nil.
+func (l *Logger) SetClock(clock Clock) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	if clock == nil {
+		clock = _SystemClock{}
+	}
+
+	l.core.clock = clock
+}
+
+// SetLevelRules sets the rules overriding the level threshold of named loggers per
+// component (see LevelRules and Named), e.g., parsed from an environment variable with
+// ParseLevelRules. The rules take precedence over the logger's level: the loggers no
+// named rule applies to use the level of the rule "*", if any, and the logger's level
+// otherwise. The logger's AtomicLevel, which may be shared, is left unchanged, so
+// removing the rules restores its level. The rules apply to the logger's sinks like its
+// level, to all the loggers derived from this one, and changes made with LevelRules.Set
+// (e.g., through flag.Var) take effect immediately. A nil value removes the rules. The
+// method is thread-safe.
+//
+// Parameters:
+//   - rules (*LevelRules): The rules to use, or nil.
+func (l *Logger) SetLevelRules(rules *LevelRules) {
+	l.core.rules.Store(rules)
+}
+
+// now returns the current time according to the logger's clock (see SetClock).
+//
+// Returns:
+//   - t (time.Time): The current time.
+func (l *Logger) now() (t time.Time) {
+	l.core.mutex.RLock()
+
+	clock := l.core.clock
+
+	l.core.mutex.RUnlock()
+
+	t = clock.Now()
+
+	return
+}
+
+// Sync flushes any data buffered by the writer and the writers of the sinks, if they
+// implement hqgologgerwriter.Flusher (e.g., the Async writer waits for its queue to
+// drain). The pending summaries of the sampler and the deduplicator, if any, are logged
+// first (see SetSampler and SetDeduplicator). Long-running programs should call it
+// before shutting down. All writers are flushed even if some fail. The method is
+// thread-safe.
+//
+// Returns:
+//   - err (error): The last error encountered while flushing, otherwise nil.
+func (l *Logger) Sync() (err error) {
+	l.core.mutex.RLock()
+
+	writer, sinks, sampler, dedup := l.core.writer, l.core.sinks, l.core.sampler, l.core.dedup
+
+	l.core.mutex.RUnlock()
+
+	if sampler != nil {
+		sampler.Flush()
+	}
+
+	if dedup != nil {
+		dedup.Flush()
+	}
+
+	if flusher, ok := writer.(hqgologgerwriter.Flusher); ok {
+		err = flusher.Flush()
+	}
+
+	for _, sink := range sinks {
+		if flushErr := sink.Flush(); flushErr != nil {
+			err = flushErr
+		}
+	}
+
+	return
+}
+
+// Close closes the writer and the writers of the sinks, releasing their resources (e.g.,
+// file handles or background goroutines). Writers deliver buffered data before closing
+// (e.g., the Async writer drains its queue within its close timeout). Messages logged
+// afterwards may be rejected by the writers. The pending summaries of the sampler and
+// the deduplicator, if any, are logged first. All writers are closed even if some fail.
+// The method is thread-safe.
+//
+// Returns:
+//   - err (error): The last error encountered while closing, otherwise nil.
+func (l *Logger) Close() (err error) {
+	l.core.mutex.RLock()
+
+	writer, sinks, sampler, dedup := l.core.writer, l.core.sinks, l.core.sampler, l.core.dedup
+
+	l.core.mutex.RUnlock()
+
+	if sampler != nil {
+		sampler.Flush()
+	}
+
+	if dedup != nil {
+		dedup.Flush()
+	}
+
+	if writer != nil {
+		err = writer.Close()
+	}
+
+	for _, sink := range sinks {
+		if closeErr := sink.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+
+	return
+}
+
+// SetCaller enables or disables capturing the source location (function, file, and line)
+// of each logged event. When enabled, the location is passed to the formatter in
+// hqgologgerformatter.Log.Caller, which the Console formatter prints as "file.go:42" and
+// structured formatters emit as a "source" object. Capturing the caller walks the stack,
+// so it is disabled by default. The method is thread-safe.
+//
+// Parameters:
+//   - enabled (bool): Whether to capture callers.
+func (l *Logger) SetCaller(enabled bool) {
+	l.core.mutex.Lock()
+	defer l.core.mutex.Unlock()
+
+	l.core.caller = enabled
+}
+
+// callerEnabled reports whether caller capture is enabled (see SetCaller).
+//
+// Returns:
+//   - enabled (bool): True if callers are captured.
+func (l *Logger) callerEnabled() (enabled bool) {
+	l.core.mutex.RLock()
+	defer l.core.mutex.RUnlock()
+
+	enabled = l.core.caller
+
+	return
+}
+
+// Enabled reports whether an event at the given level would be logged, that is, whether
+// the level is within the logger's threshold and the logger has a formatter and a
+// writer or any of its sinks accepts the level. It lets callers skip expensive work for
+// events that would be discarded.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level to check.
+//
+// Returns:
+//   - enabled (bool): True if events at the level would be logged.
+func (l *Logger) Enabled(level hqgologgerlevels.Level) (enabled bool) {
+	if level > l.core.threshold(l.name) {
+		return
+	}
+
+	pipeline := l.core.pipeline(l.name)
+
+	_, enabled = pipeline.accepts(level)
+
+	return
+}
+
+// With returns a derived logger that shares this logger's level, formatter, and writer,
+// and adds the metadata set by the provided options to every event it logs. Metadata
+// bound by the parent is inherited, and options given to With take precedence over it.
+// Metadata passed at the call site (e.g., to Info) takes precedence over bound metadata.
+// Only metadata is bound; options that set the timestamp, level, or message have no
+// effect. The bound metadata is evaluated once and copied into each event, so the derived
+// logger can be used concurrently with its parent.
+//
+// Parameters:
+//   - ofs (...OptionFunc): Options setting the metadata to bind (e.g., WithString).
+//
+// Returns:
+//   - logger (*Logger): A pointer to the derived Logger.
+func (l *Logger) With(ofs ...OptionFunc) (logger *Logger) {
+	event := &Event{
+		metadata: append(hqgologgerformatter.Fields(nil), l.metadata...),
+	}
+
+	for _, f := range ofs {
+		f(event)
+	}
+
+	logger = &Logger{
+		core:       l.core,
+		metadata:   event.metadata,
+		callerSkip: l.callerSkip,
+		name:       l.name,
+	}
+
+	return
+}
+
+// AddCallerSkip returns a derived logger, sharing this logger's configuration and bound
+// metadata, that skips the given number of additional stack frames when capturing the
+// caller. It is intended for loggers used from helper functions that wrap the logging
+// methods, so that the reported caller is the code calling the helper rather than the
+// helper itself. Skips accumulate across derived loggers.
+//
+// Parameters:
+//   - skip (int): The number of additional stack frames to skip.
+//
+// Returns:
+//   - logger (*Logger): A pointer to the derived Logger.
+func (l *Logger) AddCallerSkip(skip int) (logger *Logger) {
+	logger = &Logger{
+		core:       l.core,
+		metadata:   l.metadata,
+		callerSkip: l.callerSkip + skip,
+		name:       l.name,
+	}
+
+	return
+}
+
+// Named returns a derived logger, sharing this logger's configuration and bound
+// metadata, with the given name appended to this logger's name, separated by a dot
+// (e.g., "http" then "client" gives "http.client"). The name identifies the component
+// the logger is used by: it is passed to formatters, and selects the level rules that
+// apply to the logger (see SetLevelRules). An empty name leaves the name unchanged.
+//
+// Parameters:
+//   - name (string): The name to append.
+//
+// Returns:
+//   - logger (*Logger): A pointer to the derived Logger.
+func (l *Logger) Named(name string) (logger *Logger) {
+	switch {
+	case name == "":
+		name = l.name
+	case l.name != "":
+		name = l.name + "." + name
+	}
+
+	logger = &Logger{
+		core:       l.core,
+		metadata:   l.metadata,
+		callerSkip: l.callerSkip,
+		name:       name,
+	}
+
+	return
+}
+
+// Name returns the dot-separated name of the logger, set with Named.
+//
+// Returns:
+//   - name (string): The name of the logger, or empty if it has none.
+func (l *Logger) Name() (name string) {
+	name = l.name
 
-	l.writer = w
+	return
 }
 
 // Fatal logs a message at LevelFatal, applying the provided options (e.g., metadata, labels).
 // The message is formatted and written if the logger's threshold allows (LevelFatal = 0,
-// so it is always logged unless formatter or writer is nil). After writing, the program
-// exits with status code 1, indicating a critical failure. The method uses the options
-// pattern for flexible configuration of the log event.
+// so it is always logged unless formatter or writer is nil). After writing, the exit hooks
+// run, the writer is flushed and closed, and the program exits with the configured status
+// code (1 by default), indicating a critical failure. The method uses the options pattern
+// for flexible configuration of the log event.
 //
 // Parameters:
 //   - message (string): The log message describing the critical failure.
 //   - ofs (...OptionFunc): Optional configurations for the log event (e.g., metadata, error).
 func (l *Logger) Fatal(message string, ofs ...OptionFunc) {
-	ofs = append(ofs, _WithLevel(hqgologgerlevels.LevelFatal), _WithMessage(message))
-
-	l.Log(_NewEvent(ofs...))
+	l.log(context.Background(), 1, hqgologgerlevels.LevelFatal, message, ofs)
 }
 
 // Print logs a message at LevelSilent, applying the provided options. The message is
@@ -195,9 +812,7 @@ func (l *Logger) Fatal(message string, ofs ...OptionFunc) {
 //   - message (string): The log message for non-critical output.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func (l *Logger) Print(message string, ofs ...OptionFunc) {
-	ofs = append(ofs, _WithLevel(hqgologgerlevels.LevelSilent), _WithMessage(message))
-
-	l.Log(_NewEvent(ofs...))
+	l.log(context.Background(), 1, hqgologgerlevels.LevelSilent, message, ofs)
 }
 
 // Error logs a message at LevelError, applying the provided options. The message is
@@ -209,37 +824,31 @@ func (l *Logger) Print(message string, ofs ...OptionFunc) {
 //   - message (string): The log message describing the error.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func (l *Logger) Error(message string, ofs ...OptionFunc) {
-	ofs = append(ofs, _WithLevel(hqgologgerlevels.LevelError), _WithMessage(message))
-
-	l.Log(_NewEvent(ofs...))
+	l.log(context.Background(), 1, hqgologgerlevels.LevelError, message, ofs)
 }
 
 // Info logs a message at LevelInfo, applying the provided options. The message is
 // formatted and written if the logger's threshold allows (level <= LevelInfo). LevelInfo
-// (value 3) is used for informational messages about normal operation. The method uses
+// (value 4) is used for informational messages about normal operation. The method uses
 // the options pattern for flexibility.
 //
 // Parameters:
 //   - message (string): The log message describing normal operation.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func (l *Logger) Info(message string, ofs ...OptionFunc) {
-	ofs = append(ofs, _WithLevel(hqgologgerlevels.LevelInfo), _WithMessage(message))
-
-	l.Log(_NewEvent(ofs...))
+	l.log(context.Background(), 1, hqgologgerlevels.LevelInfo, message, ofs)
 }
 
 // Warn logs a message at LevelWarn, applying the provided options. The message is
 // formatted and written if the logger's threshold allows (level <= LevelWarn). LevelWarn
-// (value 4) indicates potential issues that do not halt execution. The method uses
+// (value 3) indicates potential issues that do not halt execution. The method uses
 // the options pattern for flexibility.
 //
 // Parameters:
 //   - message (string): The log message describing a potential issue.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func (l *Logger) Warn(message string, ofs ...OptionFunc) {
-	ofs = append(ofs, _WithLevel(hqgologgerlevels.LevelWarn), _WithMessage(message))
-
-	l.Log(_NewEvent(ofs...))
+	l.log(context.Background(), 1, hqgologgerlevels.LevelWarn, message, ofs)
 }
 
 // Debug logs a message at LevelDebug, applying the provided options. The message is
@@ -251,89 +860,533 @@ func (l *Logger) Warn(message string, ofs ...OptionFunc) {
 //   - message (string): The log message for debugging purposes.
 //   - ofs (...OptionFunc): Optional configurations for the log event.
 func (l *Logger) Debug(message string, ofs ...OptionFunc) {
-	ofs = append(ofs, _WithLevel(hqgologgerlevels.LevelDebug), _WithMessage(message))
+	l.log(context.Background(), 1, hqgologgerlevels.LevelDebug, message, ofs)
+}
 
-	l.Log(_NewEvent(ofs...))
+// Trace logs a message at LevelTrace, applying the provided options. The message is
+// formatted and written if the logger's threshold allows (level <= LevelTrace). LevelTrace
+// (value 6) is used for the most verbose output, such as function call traces, typically
+// enabled for a single component (see Named). The method uses the options pattern for
+// flexibility.
+//
+// Parameters:
+//   - message (string): The log message for tracing purposes.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) Trace(message string, ofs ...OptionFunc) {
+	l.log(context.Background(), 1, hqgologgerlevels.LevelTrace, message, ofs)
 }
 
-// Log processes a log event by filtering, formatting, and writing it. The event is ignored
-// if its level is greater than the logger's threshold (less severe). If no "label" is
-// provided in the event's metadata, a default label is added based on the level (e.g., "INF"
-// for LevelInfo). The message is trimmed of trailing newlines before formatting. If the
-// formatter or writer is nil, or if formatting fails, the event is silently ignored. For
-// LevelFatal events, the program exits with status code 1 after writing. The method is
-// thread-safe for reading configuration but relies on the formatter and writer for their
-// own thread-safety.
+// Log processes a log event by filtering, formatting, and writing it. It allows events
+// built with NewEvent, possibly in other packages, to be submitted directly. The event
+// is ignored if its level is greater than the logger's threshold (less severe). Metadata
+// bound with With is added for keys the event does not already set. If no "label" is
+// provided in the event's metadata, a default label is added based on the level (e.g.,
+// "INF" for LevelInfo). The message is trimmed of trailing newlines before formatting.
+// If the formatter or writer is nil, or if formatting fails, the event is silently
+// ignored. The event is also passed to every sink added with AddSink, which apply their
+// own level and filter on top of the logger's, and to the hooks added with AddHook
+// before formatting and after writing. Repetitive events may be dropped by the Sampler
+// set with SetSampler or collapsed by the Deduplicator set with SetDeduplicator, and
+// sensitive data is masked by the Redactor set with SetRedactor, if any. For LevelFatal
+// events, the exit hooks run, the writer is flushed and closed, and the program exits
+// with the configured status code (1 by default) after writing. If caller capture is
+// enabled (see SetCaller), the caller of Log is recorded unless the event already
+// carries a location. The method is thread-safe for reading configuration but relies on
+// the formatter and writer for their own thread-safety.
 //
 // Parameters:
-//   - event (*_Event): The log event to process, containing timestamp, level, message,
-//     and metadata.
-func (l *Logger) Log(event *_Event) {
-	l.mutex.RLock()
+//   - event (*Event): The log event to process, containing timestamp, level, message,
+//     and metadata. It is not retained after Log returns.
+func (l *Logger) Log(event *Event) {
+	l.emit(event, 1)
+}
+
+// log builds an event from the options, level, and message, adds the metadata carried
+// by ctx (if any) for keys the options did not set, and passes the event to emit. It is
+// the common path of the level-specific logging methods. The level is checked before
+// the options are evaluated and before any lock is taken, so disabled levels cost
+// neither evaluation nor allocation nor contention, and events are taken from and
+// returned to _EventPool.
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - skip (int): The number of stack frames between log and the user's code.
+//   - level (hqgologgerlevels.Level): The severity level of the event.
+//   - message (string): The log message.
+//   - ofs ([]OptionFunc): Optional configurations for the log event.
+func (l *Logger) log(ctx context.Context, skip int, level hqgologgerlevels.Level, message string, ofs []OptionFunc) {
+	if level > l.core.threshold(l.name) {
+		return
+	}
+
+	pipeline := l.core.pipeline(l.name)
+
+	if _, accepted := pipeline.accepts(level); !accepted {
+		return
+	}
+
+	event := _AcquireEvent(pipeline.clock.Now())
+
+	for _, f := range ofs {
+		f(event)
+	}
 
-	if l.formatter == nil || l.writer == nil || event.level > l.level {
-		l.mutex.RUnlock()
+	event.SetLevel(level)
+	event.SetMessage(message)
 
+	_AddContextMetadata(ctx, event)
+
+	l.process(event, skip+1, &pipeline)
+
+	_ReleaseEvent(event)
+}
+
+// emit implements Log. The skip parameter is the number of stack frames between emit and
+// the user's code, used to capture the caller when caller capture is enabled and the
+// event carries no program counter yet.
+//
+// Parameters:
+//   - event (*Event): The log event to process.
+//   - skip (int): The number of stack frames between emit and the user's code.
+func (l *Logger) emit(event *Event, skip int) {
+	if event.level > l.core.threshold(l.name) {
+		return
+	}
+
+	pipeline := l.core.pipeline(l.name)
+
+	l.process(event, skip+1, &pipeline)
+}
+
+// process implements the stages of emit following the lock-free level check, using the
+// given configuration snapshot: it checks the level against the snapshot, samples the
+// event, captures the caller, merges the bound metadata, deduplicates the event, and
+// writes it.
+//
+// Parameters:
+//   - event (*Event): The log event to process.
+//   - skip (int): The number of stack frames between process and the user's code.
+//   - pipeline (*_Pipeline): The configuration to process the event with.
+func (l *Logger) process(event *Event, skip int, pipeline *_Pipeline) {
+	if _, accepted := pipeline.accepts(event.level); !accepted {
 		return
 	}
 
-	l.mutex.RUnlock()
+	if pipeline.sampler != nil {
+		keep, summary := pipeline.sampler.sample(l, event.level, event.message, pipeline.clock.Now())
 
-	if _, ok := event.metadata["label"]; !ok {
-		labels := map[hqgologgerlevels.Level]string{
-			hqgologgerlevels.LevelFatal: "FTL",
-			hqgologgerlevels.LevelError: "ERR",
-			hqgologgerlevels.LevelInfo:  "INF",
-			hqgologgerlevels.LevelWarn:  "WRN",
-			hqgologgerlevels.LevelDebug: "DBG",
+		summary.report()
+
+		if !keep {
+			return
+		}
+	}
+
+	if pipeline.caller && event.pc == 0 {
+		pcs := [1]uintptr{}
+
+		if runtime.Callers(skip+2+l.callerSkip, pcs[:]) > 0 {
+			event.pc = pcs[0]
+		}
+	}
+
+	event.mergeMetadata(l.metadata)
+
+	if pipeline.dedup != nil {
+		keep, previous, level, repeated := pipeline.dedup.deduplicate(l, event)
+
+		if repeated > 0 {
+			summary := previous.core.pipeline(previous.name)
+
+			previous.reportRepeated(&summary, level, repeated)
+		}
+
+		if !keep {
+			return
+		}
+	}
+
+	fatal := event.level == hqgologgerlevels.LevelFatal
+
+	l.write(event, pipeline)
+
+	if fatal {
+		l.exit(event)
+	}
+}
+
+// report logs an event generated by the logger itself, such as the summaries of the
+// sampler and the deduplicator. The event bypasses sampling and deduplication.
+//
+// Parameters:
+//   - pipeline (*_Pipeline): The configuration to log the event with.
+//   - level (hqgologgerlevels.Level): The severity level of the event.
+//   - message (string): The log message.
+//   - ofs (...OptionFunc): Optional configurations for the log event.
+func (l *Logger) report(pipeline *_Pipeline, level hqgologgerlevels.Level, message string, ofs ...OptionFunc) {
+	event := _AcquireEvent(pipeline.clock.Now())
+
+	for _, f := range ofs {
+		f(event)
+	}
+
+	event.SetLevel(level)
+	event.SetMessage(message)
+	event.mergeMetadata(l.metadata)
+
+	l.write(event, pipeline)
+
+	_ReleaseEvent(event)
+}
+
+// write implements the stages of emit following the level check, sampling, and metadata
+// merge: it runs the hooks before formatting, checks the possibly changed level again,
+// adds the default label, redacts the event, formats and writes it with the primary
+// pipeline and the sinks, and runs the hooks after writing.
+//
+// Parameters:
+//   - event (*Event): The log event to write.
+//   - pipeline (*_Pipeline): The configuration to write the event with.
+func (l *Logger) write(event *Event, pipeline *_Pipeline) {
+	for _, hook := range pipeline.hooks {
+		if !hook.BeforeFormat(event) {
+			return
 		}
+	}
 
-		if label, ok := labels[event.level]; ok {
-			event.metadata["label"] = label
+	enabled, accepted := pipeline.accepts(event.level)
+	if !accepted {
+		return
+	}
+
+	if _, ok := event.metadata.Lookup("label"); !ok {
+		if label := _DefaultLabels[event.level]; label != "" {
+			event.SetLabel(label)
 		}
 	}
 
+	if pipeline.redactor != nil {
+		pipeline.redactor.Redact(event)
+	}
+
 	event.message = strings.TrimSuffix(event.message, "\n")
 
-	data, err := l.formatter.Format(&hqgologgerformatter.Log{
+	log := &event.log
+
+	*log = hqgologgerformatter.Log{
 		Timestamp: event.timestamp,
 		Message:   event.message,
 		Level:     event.level,
 		Metadata:  event.metadata,
-	})
-	if err != nil {
+		Caller:    _ResolveCaller(event.pc),
+		Name:      l.name,
+	}
+
+	var err error
+
+	if enabled {
+		err = _FormatAndWrite(pipeline.formatter, pipeline.writer, log)
+	}
+
+	for _, sink := range pipeline.sinks {
+		if sinkErr := sink.Write(log); sinkErr != nil {
+			err = sinkErr
+		}
+	}
+
+	for _, hook := range pipeline.hooks {
+		hook.AfterWrite(event, err)
+	}
+}
+
+// _Pipeline is a snapshot of the configuration an event is processed with, taken from
+// _Core once per event so that the event is processed consistently even if the
+// configuration changes meanwhile.
+//
+// Fields:
+//   - formatter (hqgologgerformatter.Formatter): The logger's formatter.
+//   - writer (hqgologgerwriter.Writer): The logger's writer.
+//   - level (hqgologgerlevels.Level): The logger's level threshold.
+//   - caller (bool): Whether callers are captured.
+//   - sinks ([]*Sink): The logger's sinks.
+//   - hooks ([]*Hook): The logger's hooks.
+//   - redactor (*Redactor): The logger's redactor, or nil.
+//   - sampler (*Sampler): The logger's sampler, or nil.
+//   - dedup (*Deduplicator): The logger's deduplicator, or nil.
+//   - clock (Clock): The logger's clock.
+type _Pipeline struct {
+	formatter hqgologgerformatter.Formatter
+	writer    hqgologgerwriter.Writer
+	level     hqgologgerlevels.Level
+	caller    bool
+	sinks     []*Sink
+	hooks     []*Hook
+	redactor  *Redactor
+	sampler   *Sampler
+	dedup     *Deduplicator
+	clock     Clock
+}
+
+// accepts reports whether events at a level are written by the primary pipeline, and
+// whether they are written at all, by it or by any of the sinks. Events beyond the level
+// threshold are written by neither, whatever the level of the sinks.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The level of the event.
+//
+// Returns:
+//   - enabled (bool): True if the primary pipeline writes the event.
+//   - accepted (bool): True if the primary pipeline or any sink writes the event.
+func (p *_Pipeline) accepts(level hqgologgerlevels.Level) (enabled, accepted bool) {
+	if level > p.level {
 		return
 	}
 
-	l.writer.Write(data, event.level)
+	enabled = p.formatter != nil && p.writer != nil
+	accepted = enabled
+
+	for _, sink := range p.sinks {
+		accepted = accepted || sink.Enabled(level)
+	}
+
+	return
+}
+
+// threshold resolves the level threshold of the logger with the given name without
+// locking: the level of the level rule for the name, if any applies (see
+// LevelRules.Level), or else the level of the core. It lets disabled levels be
+// discarded before a snapshot is taken.
+//
+// Parameters:
+//   - name (string): The name of the logger.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The level threshold.
+func (c *_Core) threshold(name string) (level hqgologgerlevels.Level) {
+	level = c.level.Load().Level()
+
+	if rules := c.rules.Load(); rules != nil {
+		if ruled, ok := rules.Level(name); ok {
+			level = ruled
+		}
+	}
+
+	return
+}
 
-	if event.level == hqgologgerlevels.LevelFatal {
-		os.Exit(1)
+// pipeline takes a snapshot of the configuration the events of a logger are processed
+// with. The level threshold is resolved as described for threshold.
+//
+// Parameters:
+//   - name (string): The name of the logger.
+//
+// Returns:
+//   - pipeline (_Pipeline): The snapshot.
+func (c *_Core) pipeline(name string) (pipeline _Pipeline) {
+	level := c.threshold(name)
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	pipeline = _Pipeline{
+		formatter: c.formatter,
+		writer:    c.writer,
+		level:     level,
+		caller:    c.caller,
+		sinks:     c.sinks,
+		hooks:     c.hooks,
+		redactor:  c.redactor,
+		sampler:   c.sampler,
+		dedup:     c.dedup,
+		clock:     c.clock,
 	}
+
+	return
 }
 
+// exit terminates the program after a LevelFatal event: it runs the exit hooks, closes
+// the writer so that no buffered message (including the fatal one) is lost,
+// and calls the exit function with the event's exit code, or the logger's if the event
+// sets none.
+//
+// Parameters:
+//   - event (*Event): The LevelFatal event that was logged.
+func (l *Logger) exit(event *Event) {
+	l.core.mutex.RLock()
+
+	exit, code, hooks := l.core.exit, l.core.exitCode, l.core.exitHooks
+
+	l.core.mutex.RUnlock()
+
+	if event.exitCode != 0 {
+		code = event.exitCode
+	}
+
+	for _, hook := range hooks {
+		hook()
+	}
+
+	_ = l.Close()
+
+	exit(code)
+}
+
+// _ResolveCaller resolves a program counter into the caller passed to formatters.
+//
+// Parameters:
+//   - pc (uintptr): The program counter to resolve.
+//
+// Returns:
+//   - caller (*hqgologgerformatter.Caller): The resolved caller, or nil if pc is zero or
+//     cannot be resolved.
+func _ResolveCaller(pc uintptr) (caller *hqgologgerformatter.Caller) {
+	if pc == 0 {
+		return
+	}
+
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+
+	if frame.File == "" {
+		return
+	}
+
+	caller = &hqgologgerformatter.Caller{
+		Function: frame.Function,
+		File:     frame.File,
+		Line:     frame.Line,
+	}
+
+	return
+}
+
+// _AcquireEvent takes an event from _EventPool and sets its timestamp.
+//
+// Parameters:
+//   - timestamp (time.Time): The timestamp of the event, usually the current time.
+//
+// Returns:
+//   - event (*Event): The event, with no metadata.
+func _AcquireEvent(timestamp time.Time) (event *Event) {
+	event, _ = _EventPool.Get().(*Event)
+
+	event.timestamp = timestamp
+
+	return
+}
+
+// _ReleaseEvent resets an event taken with _AcquireEvent and returns it to _EventPool,
+// unless its metadata buffers have grown beyond _EventPoolMaxFields. The event must not
+// be used afterwards.
+//
+// Parameters:
+//   - event (*Event): The event to release.
+func _ReleaseEvent(event *Event) {
+	if cap(event.metadata) > _EventPoolMaxFields || cap(event.spare) > _EventPoolMaxFields {
+		return
+	}
+
+	event.reset()
+
+	_EventPool.Put(event)
+}
+
+// _FormatAndWrite formats a log and writes the result to a writer. Formatters
+// implementing hqgologgerformatter.BufferFormatter format into a buffer taken from
+// _BufferPool, which is returned to the pool once written; writers must therefore not
+// retain the data passed to Write.
+//
+// Parameters:
+//   - formatter (hqgologgerformatter.Formatter): The formatter to use.
+//   - writer (hqgologgerwriter.Writer): The writer to write to.
+//   - log (*hqgologgerformatter.Log): The log to format.
+//
+// Returns:
+//   - err (error): An error if formatting or writing fails, otherwise nil.
+func _FormatAndWrite(formatter hqgologgerformatter.Formatter, writer hqgologgerwriter.Writer, log *hqgologgerformatter.Log) (err error) {
+	bf, ok := formatter.(hqgologgerformatter.BufferFormatter)
+	if !ok {
+		var data []byte
+
+		if data, err = formatter.Format(log); err != nil {
+			return
+		}
+
+		err = writer.Write(data, log.Level)
+
+		return
+	}
+
+	buffer, _ := _BufferPool.Get().(*bytes.Buffer)
+
+	buffer.Reset()
+
+	if err = bf.FormatTo(buffer, log); err == nil {
+		err = writer.Write(buffer.Bytes(), log.Level)
+	}
+
+	if buffer.Cap() <= _BufferPoolMaxSize {
+		_BufferPool.Put(buffer)
+	}
+
+	return
+}
+
+var (
+	// _DefaultLabels maps levels to the labels added to events that set none.
+	_DefaultLabels = map[hqgologgerlevels.Level]string{
+		hqgologgerlevels.LevelFatal: "FTL",
+		hqgologgerlevels.LevelError: "ERR",
+		hqgologgerlevels.LevelWarn:  "WRN",
+		hqgologgerlevels.LevelInfo:  "INF",
+		hqgologgerlevels.LevelDebug: "DBG",
+		hqgologgerlevels.LevelTrace: "TRC",
+	}
+	// _EventPool holds events reused by the level-specific logging methods.
+	_EventPool = sync.Pool{
+		New: func() any {
+			return &Event{}
+		},
+	}
+	// _BufferPool holds buffers reused for formatting.
+	_BufferPool = sync.Pool{
+		New: func() any {
+			return &bytes.Buffer{}
+		},
+	}
+)
+
+const (
+	// _EventPoolMaxFields is the metadata capacity above which events are not returned
+	// to _EventPool, so that occasional large events do not pin memory.
+	_EventPoolMaxFields = 64
+	// _BufferPoolMaxSize is the capacity above which buffers are not returned to
+	// _BufferPool.
+	_BufferPoolMaxSize = 64 << 10
+)
+
 // OptionFunc defines a function type for configuring log events using the options pattern.
 // It allows flexible modification of an event’s fields (e.g., level, message, metadata)
 // during creation or logging.
 //
 // Parameters:
-//   - event (*_Event): The log event to configure.
-type OptionFunc func(event *_Event)
+//   - event (*Event): The log event to configure.
+type OptionFunc func(event *Event)
 
-// _NewEvent creates a new log event with the specified options. It initializes the event
-// with an empty metadata map and applies the provided OptionFunc configurations to set
-// the timestamp, level, message, and metadata. The timestamp is typically set by formatters
-// or writers if not explicitly configured.
+// NewEvent creates a new log event with the specified options. It initializes the event
+// with the current time and no metadata, and applies the provided OptionFunc
+// configurations to set the timestamp, level, message, and metadata. The event can be
+// submitted with Logger.Log, which allows packages to construct events directly, e.g.,
+// logger.Log(hqgologger.NewEvent(hqgologger.WithLevel(levels.LevelInfo),
+// hqgologger.WithMessage("Started"))).
 //
 // Parameters:
 //   - ofs (...OptionFunc): Configurations for the log event (e.g., level, message, metadata).
 //
 // Returns:
-//   - event (*_Event): A pointer to the configured log event.
-func _NewEvent(ofs ...OptionFunc) (event *_Event) {
-	event = &_Event{
+//   - event (*Event): A pointer to the configured log event.
+func NewEvent(ofs ...OptionFunc) (event *Event) {
+	event = &Event{
 		timestamp: time.Now(),
-		metadata:  make(map[string]any),
 	}
 
 	for _, f := range ofs {
@@ -343,38 +1396,52 @@ func _NewEvent(ofs ...OptionFunc) (event *_Event) {
 	return
 }
 
-// _WithLevel returns an OptionFunc that sets the severity level of a log event. This is
-// an internal helper used by level-specific logging methods (e.g., Info, Fatal) to configure
-// the event’s level.
+// WithLevel returns an OptionFunc that sets the severity level of a log event, used when
+// constructing events with NewEvent. The level-specific logging methods (e.g., Info,
+// Fatal) set the level after applying options, so it has no effect there.
 //
 // Parameters:
 //   - level (hqgologgerlevels.Level): The severity level to set.
 //
 // Returns:
 //   - (OptionFunc): A function to configure the event’s level.
-func _WithLevel(level hqgologgerlevels.Level) OptionFunc {
-	return func(event *_Event) {
+func WithLevel(level hqgologgerlevels.Level) OptionFunc {
+	return func(event *Event) {
 		event.SetLevel(level)
 	}
 }
 
-// _WithMessage returns an OptionFunc that sets the message content of a log event. This
-// is an internal helper used by level-specific logging methods to configure the event’s
-// message.
+// WithMessage returns an OptionFunc that sets the message content of a log event, used
+// when constructing events with NewEvent. The level-specific logging methods set the
+// message after applying options, so it has no effect there.
 //
 // Parameters:
 //   - message (string): The log message to set.
 //
 // Returns:
 //   - (OptionFunc): A function to configure the event’s message.
-func _WithMessage(message string) OptionFunc {
-	return func(event *_Event) {
+func WithMessage(message string) OptionFunc {
+	return func(event *Event) {
 		event.SetMessage(message)
 	}
 }
 
+// WithTimestamp returns an OptionFunc that sets the timestamp of a log event, overriding
+// the time it was created at.
+//
+// Parameters:
+//   - t (time.Time): The timestamp to set.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event’s timestamp.
+func WithTimestamp(t time.Time) OptionFunc {
+	return func(event *Event) {
+		event.SetTimestamp(t)
+	}
+}
+
 func WithoutTimestamp() OptionFunc {
-	return func(event *_Event) {
+	return func(event *Event) {
 		var timestamp time.Time
 
 		event.SetTimestamp(timestamp)
@@ -382,7 +1449,7 @@ func WithoutTimestamp() OptionFunc {
 }
 
 func WithValue(key string, value any) OptionFunc {
-	return func(event *_Event) {
+	return func(event *Event) {
 		event.SetValue(key, value)
 	}
 }
@@ -398,7 +1465,7 @@ func WithValue(key string, value any) OptionFunc {
 // Returns:
 //   - (OptionFunc): A function to configure the event’s metadata with a string value.
 func WithString(key, value string) OptionFunc {
-	return func(event *_Event) {
+	return func(event *Event) {
 		event.SetString(key, value)
 	}
 }
@@ -413,17 +1480,32 @@ func WithString(key, value string) OptionFunc {
 // Returns:
 //   - (OptionFunc): A function to configure the event’s label.
 func WithLabel(label string) OptionFunc {
-	return func(event *_Event) {
+	return func(event *Event) {
 		event.SetLabel(label)
 	}
 }
 
 func WithoutLabel() OptionFunc {
-	return func(event *_Event) {
+	return func(event *Event) {
 		event.SetLabel("")
 	}
 }
 
+// WithExitCode returns an OptionFunc that sets the status code the program exits with
+// after a LevelFatal event, overriding the logger's exit code (see Logger.SetExitCode).
+// It has no effect on events at other levels, and a zero code is ignored.
+//
+// Parameters:
+//   - code (int): The exit status code.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event’s exit code.
+func WithExitCode(code int) OptionFunc {
+	return func(event *Event) {
+		event.SetExitCode(code)
+	}
+}
+
 // WithError returns an OptionFunc that adds an error to a log event’s metadata under
 // the "error" key. The error is stored as-is, and formatters are responsible for
 // converting it to a string or other format (e.g., including stack traces). It can be
@@ -435,22 +1517,30 @@ func WithoutLabel() OptionFunc {
 // Returns:
 //   - (OptionFunc): A function to configure the event’s error metadata.
 func WithError(err error) OptionFunc {
-	return func(event *_Event) {
+	return func(event *Event) {
 		event.SetError(err)
 	}
 }
 
 // NewLogger creates and returns a new Logger instance with a read-write mutex for
-// thread-safe configuration but no formatter, writer, or level set. Users must configure
-// the logger with a level, formatter, and writer before use to avoid silent failures
-// during logging. The logger is ready for customization and use in a logging system.
+// thread-safe configuration but no formatter, writer, or level set, and no bound
+// metadata. Users must configure the logger with a level, formatter, and writer before
+// use to avoid silent failures during logging. The logger is ready for customization
+// and use in a logging system.
 //
 // Returns:
 //   - logger (*Logger): A pointer to a new Logger instance with a mutex initialized.
 func NewLogger() (logger *Logger) {
 	logger = &Logger{
-		mutex: &sync.RWMutex{},
+		core: &_Core{
+			mutex:    &sync.RWMutex{},
+			exit:     os.Exit,
+			exitCode: 1,
+			clock:    _SystemClock{},
+		},
 	}
 
+	logger.core.level.Store(NewAtomicLevel(hqgologgerlevels.LevelFatal))
+
 	return
 }
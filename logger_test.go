@@ -0,0 +1,175 @@
+package logger_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+// newDiscardLogger returns a logger at the given level formatting events as JSON and
+// discarding the output.
+func newDiscardLogger(level hqgologgerlevels.Level) (logger *hqgologger.Logger) {
+	cfg := hqgologgerwriter.DefaultConsoleWriterConfig()
+
+	cfg.Stdout = io.Discard
+	cfg.Stderr = io.Discard
+
+	logger = hqgologger.NewLogger()
+
+	logger.SetLevel(level)
+	logger.SetFormatter(hqgologgerformatter.NewJSONFormatter(nil))
+	logger.SetWriter(hqgologgerwriter.NewConsoleWriter(cfg))
+
+	return
+}
+
+func TestLoggerZeroAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are unreliable with the race detector")
+	}
+
+	logger := newDiscardLogger(hqgologgerlevels.LevelInfo)
+
+	body := []byte(`{"status":"ok"}`)
+
+	tests := []struct {
+		name string
+		log  func()
+	}{
+		{
+			name: "disabled",
+			log: func() {
+				logger.Debug("disabled", hqgologger.WithString("key", "value"), hqgologger.WithInt("count", 42))
+			},
+		},
+		{
+			name: "disabled builder",
+			log: func() {
+				logger.AtDebug().Str("key", "value").Int("count", 42).Send()
+			},
+		},
+		{
+			name: "enabled",
+			log: func() {
+				logger.Info("enabled", hqgologger.WithString("key", "value"), hqgologger.WithInt("count", 42),
+					hqgologger.WithDuration("elapsed", time.Second))
+			},
+		},
+		{
+			name: "enabled bytes",
+			log: func() {
+				logger.Info("enabled", hqgologger.WithBytes("body", body))
+			},
+		},
+		{
+			name: "enabled builder",
+			log: func() {
+				logger.AtInfo().Str("key", "value").Int("count", 42).Duration("elapsed", time.Second).Send()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		if allocs := testing.AllocsPerRun(100, test.log); allocs != 0 {
+			t.Errorf("%s: got %v allocations per call, want 0", test.name, allocs)
+		}
+	}
+}
+
+func TestWithBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		formatter hqgologgerformatter.Formatter
+		want      string
+	}{
+		{name: "json", formatter: hqgologgerformatter.NewJSONFormatter(nil), want: `"body":"say \"hi\""`},
+		{name: "logfmt", formatter: hqgologgerformatter.NewLogfmtFormatter(nil), want: `body="say \"hi\""`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			cfg := hqgologgerwriter.DefaultConsoleWriterConfig()
+
+			cfg.Stdout = &output
+			cfg.Stderr = &output
+
+			logger := hqgologger.NewLogger()
+
+			logger.SetLevel(hqgologgerlevels.LevelInfo)
+			logger.SetFormatter(test.formatter)
+			logger.SetWriter(hqgologgerwriter.NewConsoleWriter(cfg))
+
+			logger.Info("response", hqgologger.WithBytes("body", []byte(`say "hi"`)))
+
+			if got := output.String(); !strings.Contains(got, test.want) {
+				t.Errorf("output = %q, want it to contain %q", got, test.want)
+			}
+		})
+	}
+}
+
+func BenchmarkDisabled(b *testing.B) {
+	logger := newDiscardLogger(hqgologgerlevels.LevelInfo)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		logger.Debug("disabled", hqgologger.WithString("key", "value"), hqgologger.WithInt("count", 42))
+	}
+}
+
+func BenchmarkDisabledBuilder(b *testing.B) {
+	logger := newDiscardLogger(hqgologgerlevels.LevelInfo)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		logger.AtDebug().Str("key", "value").Int("count", 42).Send()
+	}
+}
+
+func BenchmarkEnabled(b *testing.B) {
+	logger := newDiscardLogger(hqgologgerlevels.LevelInfo)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		logger.Info("enabled", hqgologger.WithString("key", "value"), hqgologger.WithInt("count", 42),
+			hqgologger.WithDuration("elapsed", time.Second))
+	}
+}
+
+func BenchmarkEnabledBuilder(b *testing.B) {
+	logger := newDiscardLogger(hqgologgerlevels.LevelInfo)
+
+	b.ReportAllocs()
+
+	for b.Loop() {
+		logger.AtInfo().Str("key", "value").Int("count", 42).Duration("elapsed", time.Second).Send()
+	}
+}
+
+func BenchmarkEnabledParallel(b *testing.B) {
+	logger := newDiscardLogger(hqgologgerlevels.LevelInfo)
+
+	b.ReportAllocs()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			logger.Info("enabled", hqgologger.WithString("key", "value"), hqgologger.WithInt("count", 42))
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package loggertest
+
+import (
+	"sync"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+)
+
+// Clock is a deterministic hqgologger.Clock for tests (see Logger.SetClock). It starts at
+// a fixed time and advances by a fixed step each time it is read, so that successive
+// events get distinct but reproducible timestamps. A Clock is safe for concurrent use.
+//
+// Fields:
+//   - mutex (*sync.Mutex): Serializes access to the current time.
+//   - now (time.Time): The time returned by the next call to Now.
+//   - step (time.Duration): The duration the clock advances by on each call to Now.
+type Clock struct {
+	mutex *sync.Mutex
+	now   time.Time
+	step  time.Duration
+}
+
+// Now returns the current time of the clock and advances it by its step.
+//
+// Returns:
+//   - t (time.Time): The current time of the clock.
+func (c *Clock) Now() (t time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	t = c.now
+
+	c.now = c.now.Add(c.step)
+
+	return
+}
+
+// Set sets the time returned by the next call to Now.
+//
+// Parameters:
+//   - t (time.Time): The time to set.
+func (c *Clock) Set(t time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = t
+}
+
+// Add advances the clock by the given duration.
+//
+// Parameters:
+//   - d (time.Duration): The duration to advance by.
+func (c *Clock) Add(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+var _ hqgologger.Clock = (*Clock)(nil)
+
+// NewClock creates and returns a new Clock starting at the given time and advancing by
+// the given step on each call to Now. A zero step makes the clock fixed.
+//
+// Parameters:
+//   - start (time.Time): The time returned by the first call to Now.
+//   - step (time.Duration): The duration the clock advances by on each call to Now.
+//
+// Returns:
+//   - clock (*Clock): A pointer to a new Clock instance.
+func NewClock(start time.Time, step time.Duration) (clock *Clock) {
+	clock = &Clock{
+		mutex: &sync.Mutex{},
+		now:   start,
+		step:  step,
+	}
+
+	return
+}
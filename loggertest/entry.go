@@ -0,0 +1,250 @@
+package loggertest
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// Entry is a log event captured by a Recorder, holding the event as passed to
+// formatters rather than its formatted bytes.
+//
+// Fields:
+//   - Timestamp (time.Time): The time the event was created.
+//   - Level (hqgologgerlevels.Level): The severity level of the event.
+//   - Message (string): The message of the event.
+//   - Metadata (hqgologgerformatter.Fields): A copy of the metadata of the event,
+//     including its label and the metadata bound with With.
+//   - Caller (*hqgologgerformatter.Caller): The source location of the event, or nil if
+//     caller capture is disabled.
+//   - Name (string): The name of the logger the event was logged with, or empty.
+type Entry struct {
+	Timestamp time.Time
+	Level     hqgologgerlevels.Level
+	Message   string
+	Metadata  hqgologgerformatter.Fields
+	Caller    *hqgologgerformatter.Caller
+	Name      string
+}
+
+// Value returns the metadata value stored under the given key.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//
+// Returns:
+//   - value (any): The stored value, or nil if the key is not set.
+//   - ok (bool): True if the key is set.
+func (e Entry) Value(key string) (value any, ok bool) {
+	value, ok = e.Metadata.Get(key)
+
+	return
+}
+
+// log returns the entry as a log to pass to formatters.
+//
+// Returns:
+//   - log (*hqgologgerformatter.Log): The log.
+func (e Entry) log() (log *hqgologgerformatter.Log) {
+	log = &hqgologgerformatter.Log{
+		Timestamp: e.Timestamp,
+		Level:     e.Level,
+		Message:   e.Message,
+		Metadata:  e.Metadata,
+		Caller:    e.Caller,
+		Name:      e.Name,
+	}
+
+	return
+}
+
+// Entries is a list of captured log events, with helpers to select among them.
+type Entries []Entry
+
+// FilterLevel returns the entries at the given level.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level to select.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (e Entries) FilterLevel(level hqgologgerlevels.Level) (entries Entries) {
+	entries = e.Filter(func(entry Entry) bool {
+		return entry.Level == level
+	})
+
+	return
+}
+
+// FilterName returns the entries logged with the logger of the given name.
+//
+// Parameters:
+//   - name (string): The logger name to select.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (e Entries) FilterName(name string) (entries Entries) {
+	entries = e.Filter(func(entry Entry) bool {
+		return entry.Name == name
+	})
+
+	return
+}
+
+// FilterMessage returns the entries with the given message.
+//
+// Parameters:
+//   - message (string): The message to select.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (e Entries) FilterMessage(message string) (entries Entries) {
+	entries = e.Filter(func(entry Entry) bool {
+		return entry.Message == message
+	})
+
+	return
+}
+
+// FilterMessageSnippet returns the entries whose message contains the given text.
+//
+// Parameters:
+//   - snippet (string): The text to look for.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (e Entries) FilterMessageSnippet(snippet string) (entries Entries) {
+	entries = e.Filter(func(entry Entry) bool {
+		return strings.Contains(entry.Message, snippet)
+	})
+
+	return
+}
+
+// FilterField returns the entries whose metadata holds the given value under the given
+// key. Values are compared with reflect.DeepEqual against the value stored in the
+// metadata, after converting both from any built-in integer type to int64 (or uint64
+// for unsigned values beyond the range of int64) and from float32 to float64, so that,
+// e.g., FilterField("port", 8080) matches WithInt("port", 8080), which stores an int64.
+// Other values, including named types such as time.Duration, are compared as they are.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (any): The expected value.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (e Entries) FilterField(key string, value any) (entries Entries) {
+	entries = e.Filter(func(entry Entry) bool {
+		v, ok := entry.Value(key)
+
+		return ok && reflect.DeepEqual(_NormalizeNumber(v), _NormalizeNumber(value))
+	})
+
+	return
+}
+
+// _NormalizeNumber converts a value of a built-in integer type to int64, or to uint64
+// for unsigned values beyond the range of int64, and a float32 to float64. Other values
+// are returned as they are.
+//
+// Parameters:
+//   - value (any): The value to convert.
+//
+// Returns:
+//   - normalized (any): The converted value.
+func _NormalizeNumber(value any) (normalized any) {
+	normalized = value
+
+	switch v := value.(type) {
+	case int:
+		normalized = int64(v)
+	case int8:
+		normalized = int64(v)
+	case int16:
+		normalized = int64(v)
+	case int32:
+		normalized = int64(v)
+	case uint:
+		normalized = _NormalizeUnsigned(uint64(v))
+	case uint8:
+		normalized = int64(v)
+	case uint16:
+		normalized = int64(v)
+	case uint32:
+		normalized = int64(v)
+	case uint64:
+		normalized = _NormalizeUnsigned(v)
+	case float32:
+		normalized = float64(v)
+	}
+
+	return
+}
+
+// _NormalizeUnsigned converts an unsigned value to int64 if it is within its range.
+//
+// Parameters:
+//   - value (uint64): The value to convert.
+//
+// Returns:
+//   - normalized (any): The value as an int64, or as a uint64 beyond the range of int64.
+func _NormalizeUnsigned(value uint64) (normalized any) {
+	normalized = value
+
+	if value <= math.MaxInt64 {
+		normalized = int64(value)
+	}
+
+	return
+}
+
+// FilterFieldKey returns the entries whose metadata sets the given key.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (e Entries) FilterFieldKey(key string) (entries Entries) {
+	entries = e.Filter(func(entry Entry) bool {
+		_, ok := entry.Value(key)
+
+		return ok
+	})
+
+	return
+}
+
+// Filter returns the entries for which keep returns true.
+//
+// Parameters:
+//   - keep (func(entry Entry) bool): The predicate selecting entries.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (e Entries) Filter(keep func(entry Entry) bool) (entries Entries) {
+	for _, entry := range e {
+		if keep(entry) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return
+}
+
+// Messages returns the messages of the entries, in order.
+//
+// Returns:
+//   - messages ([]string): The messages.
+func (e Entries) Messages() (messages []string) {
+	for _, entry := range e {
+		messages = append(messages, entry.Message)
+	}
+
+	return
+}
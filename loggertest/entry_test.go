@@ -0,0 +1,62 @@
+package loggertest_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestEntriesFilters(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.Info("listening", hqgologger.WithInt("port", 8080), hqgologger.WithFloat64("ratio", 0.5))
+	logger.Named("db").Warn("slow query", hqgologger.WithDuration("elapsed", time.Second), hqgologger.WithValue("rows", uint8(3)))
+	logger.Named("db").Error("query failed", hqgologger.WithString("table", "users"), hqgologger.WithValue("big", uint64(1<<63)))
+
+	entries := recorder.All()
+
+	tests := []struct {
+		name string
+		got  hqgologgerloggertest.Entries
+		want []string
+	}{
+		{name: "level", got: entries.FilterLevel(hqgologgerlevels.LevelWarn), want: []string{"slow query"}},
+		{name: "name", got: entries.FilterName("db"), want: []string{"slow query", "query failed"}},
+		{name: "message", got: entries.FilterMessage("listening"), want: []string{"listening"}},
+		{name: "message snippet", got: entries.FilterMessageSnippet("query"), want: []string{"slow query", "query failed"}},
+		{name: "field int", got: entries.FilterField("port", 8080), want: []string{"listening"}},
+		{name: "field int64", got: entries.FilterField("port", int64(8080)), want: []string{"listening"}},
+		{name: "field uint16", got: entries.FilterField("port", uint16(8080)), want: []string{"listening"}},
+		{name: "field int mismatch", got: entries.FilterField("port", 80), want: nil},
+		{name: "field float32", got: entries.FilterField("ratio", float32(0.5)), want: []string{"listening"}},
+		{name: "field stored uint8", got: entries.FilterField("rows", 3), want: []string{"slow query"}},
+		{name: "field big uint64", got: entries.FilterField("big", uint64(1<<63)), want: []string{"query failed"}},
+		{name: "field duration", got: entries.FilterField("elapsed", time.Second), want: []string{"slow query"}},
+		{name: "field string", got: entries.FilterField("table", "users"), want: []string{"query failed"}},
+		{name: "field key", got: entries.FilterFieldKey("table"), want: []string{"query failed"}},
+		{name: "filter", got: entries.Filter(func(entry hqgologgerloggertest.Entry) bool {
+			return entry.Level <= hqgologgerlevels.LevelWarn
+		}), want: []string{"slow query", "query failed"}},
+		{name: "chained", got: recorder.FilterLevel(hqgologgerlevels.LevelError).FilterName("db"), want: []string{"query failed"}},
+	}
+
+	for _, test := range tests {
+		if got := test.got.Messages(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	if got := recorder.FilterField("port", 8080).Messages(); !reflect.DeepEqual(got, []string{"listening"}) {
+		t.Errorf("Recorder.FilterField() = %q, want [\"listening\"]", got)
+	}
+
+	if got := recorder.FilterMessage("slow query"); len(got) != 1 {
+		t.Errorf("Recorder.FilterMessage() returned %d entries, want 1", len(got))
+	}
+}
@@ -0,0 +1,106 @@
+package loggertest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+)
+
+// UpdateGoldenEnv is the environment variable that, when set to a non-empty value, makes
+// AssertGolden and AssertGoldenEntries write the golden files instead of comparing
+// against them, e.g., UPDATE_GOLDEN=1 go test ./....
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// Render formats entries with a formatter, one per line, as a Logger would write them
+// to a Console writer.
+//
+// Parameters:
+//   - formatter (hqgologgerformatter.Formatter): The formatter to render with.
+//   - entries (Entries): The entries to render.
+//
+// Returns:
+//   - data ([]byte): The rendered entries, each followed by a newline.
+//   - err (error): An error if formatting an entry fails, otherwise nil.
+func Render(formatter hqgologgerformatter.Formatter, entries Entries) (data []byte, err error) {
+	for _, entry := range entries {
+		var line []byte
+
+		line, err = formatter.Format(entry.log())
+		if err != nil {
+			return
+		}
+
+		data = append(data, line...)
+		data = append(data, '\n')
+	}
+
+	return
+}
+
+// AssertGolden compares data with the content of a golden file (e.g.,
+// "testdata/output.golden"), failing the test if they differ or the file cannot be
+// read. If the UpdateGoldenEnv environment variable is set, the golden file is written
+// with data instead, creating its directory if needed. It is typically used with a
+// deterministic Clock and a Console writer whose streams are set to a bytes.Buffer.
+//
+// Parameters:
+//   - tb (testing.TB): The test the assertion is made in.
+//   - path (string): The path of the golden file.
+//   - data ([]byte): The output to compare.
+func AssertGolden(tb testing.TB, path string, data []byte) {
+	tb.Helper()
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			tb.Fatalf("loggertest: creating golden file directory: %v", err)
+		}
+
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			tb.Fatalf("loggertest: writing golden file: %v", err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("loggertest: reading golden file (set %s=1 to create it): %v", UpdateGoldenEnv, err)
+	}
+
+	if bytes.Equal(data, want) {
+		return
+	}
+
+	gotLines, wantLines := bytes.Split(data, []byte("\n")), bytes.Split(want, []byte("\n"))
+
+	line := 0
+
+	for line < len(gotLines) && line < len(wantLines) && bytes.Equal(gotLines[line], wantLines[line]) {
+		line++
+	}
+
+	tb.Errorf("loggertest: output differs from %s at line %d (set %s=1 to update it)\ngot:\n%s\nwant:\n%s",
+		path, line+1, UpdateGoldenEnv, data, want)
+}
+
+// AssertGoldenEntries renders entries with a formatter (see Render) and compares the
+// result with the content of a golden file (see AssertGolden).
+//
+// Parameters:
+//   - tb (testing.TB): The test the assertion is made in.
+//   - path (string): The path of the golden file.
+//   - formatter (hqgologgerformatter.Formatter): The formatter to render with.
+//   - entries (Entries): The entries to render.
+func AssertGoldenEntries(tb testing.TB, path string, formatter hqgologgerformatter.Formatter, entries Entries) {
+	tb.Helper()
+
+	data, err := Render(formatter, entries)
+	if err != nil {
+		tb.Fatalf("loggertest: rendering entries: %v", err)
+	}
+
+	AssertGolden(tb, path, data)
+}
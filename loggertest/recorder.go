@@ -0,0 +1,284 @@
+package loggertest
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+// Recorder captures the events logged by a Logger in memory, as structured entries
+// rather than bytes, so that tests can assert on them without parsing formatted output.
+// It is attached to a Logger as a sink (see Sink), to which it acts as both the formatter
+// and the writer. A Recorder is safe for concurrent use.
+//
+// Fields:
+//   - cfg (*RecorderConfiguration): Configuration settings for the recorder.
+//   - mutex (*sync.Mutex): Serializes access to the entries.
+//   - entries (Entries): The captured entries.
+type Recorder struct {
+	cfg     *RecorderConfiguration
+	mutex   *sync.Mutex
+	entries Entries
+}
+
+// Format captures a copy of the log as an entry. It returns no data, as the recorder
+// does not write anything.
+//
+// Parameters:
+//   - log (*hqgologgerformatter.Log): The log to capture.
+//
+// Returns:
+//   - data ([]byte): Always nil.
+//   - err (error): Always nil.
+func (r *Recorder) Format(log *hqgologgerformatter.Log) (data []byte, err error) {
+	entry := Entry{
+		Timestamp: log.Timestamp,
+		Level:     log.Level,
+		Message:   log.Message,
+		Metadata:  slices.Clone(log.Metadata),
+		Name:      log.Name,
+	}
+
+	if log.Caller != nil {
+		caller := *log.Caller
+
+		entry.Caller = &caller
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.entries = append(r.entries, entry)
+
+	return
+}
+
+// Write discards the data, as the entry was already captured by Format.
+//
+// Parameters:
+//   - data ([]byte): The formatted data, ignored.
+//   - level (hqgologgerlevels.Level): The level of the event, ignored.
+//
+// Returns:
+//   - err (error): Always nil.
+func (r *Recorder) Write(_ []byte, _ hqgologgerlevels.Level) (err error) {
+	return
+}
+
+// Close does nothing; the captured entries remain available.
+//
+// Returns:
+//   - err (error): Always nil.
+func (r *Recorder) Close() (err error) {
+	return
+}
+
+// Sink returns a sink capturing the events of a Logger into the recorder, at the levels
+// enabled by the recorder's configuration.
+//
+// Returns:
+//   - sink (*hqgologger.Sink): The sink to add with Logger.AddSink.
+func (r *Recorder) Sink() (sink *hqgologger.Sink) {
+	sink = hqgologger.NewSink(&hqgologger.SinkConfiguration{
+		Formatter: r,
+		Writer:    r,
+		Level:     r.cfg.Level,
+		Filter:    nil,
+	})
+
+	return
+}
+
+// All returns a copy of the captured entries, in the order they were logged.
+//
+// Returns:
+//   - entries (Entries): The captured entries.
+func (r *Recorder) All() (entries Entries) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entries = slices.Clone(r.entries)
+
+	return
+}
+
+// TakeAll returns the captured entries and clears them, so that later assertions only
+// see the entries logged afterwards.
+//
+// Returns:
+//   - entries (Entries): The captured entries.
+func (r *Recorder) TakeAll() (entries Entries) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entries, r.entries = r.entries, nil
+
+	return
+}
+
+// Len returns the number of captured entries.
+//
+// Returns:
+//   - length (int): The number of captured entries.
+func (r *Recorder) Len() (length int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	length = len(r.entries)
+
+	return
+}
+
+// FilterLevel returns the captured entries at the given level. See Entries.FilterLevel.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level to select.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (r *Recorder) FilterLevel(level hqgologgerlevels.Level) (entries Entries) {
+	entries = r.All().FilterLevel(level)
+
+	return
+}
+
+// FilterMessage returns the captured entries with the given message. See
+// Entries.FilterMessage.
+//
+// Parameters:
+//   - message (string): The message to select.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (r *Recorder) FilterMessage(message string) (entries Entries) {
+	entries = r.All().FilterMessage(message)
+
+	return
+}
+
+// FilterField returns the captured entries whose metadata holds the given value under
+// the given key. See Entries.FilterField.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (any): The expected value.
+//
+// Returns:
+//   - entries (Entries): The matching entries.
+func (r *Recorder) FilterField(key string, value any) (entries Entries) {
+	entries = r.All().FilterField(key, value)
+
+	return
+}
+
+// RecorderConfiguration defines configuration options for a Recorder.
+//
+// Fields:
+//   - Level (hqgologgerlevels.Level): The minimum severity level captured (inclusive).
+type RecorderConfiguration struct {
+	Level hqgologgerlevels.Level
+}
+
+var (
+	_ hqgologgerformatter.Formatter = (*Recorder)(nil)
+	_ hqgologgerwriter.Writer       = (*Recorder)(nil)
+)
+
+// DefaultRecorderConfig returns a default configuration for a Recorder, which captures
+// all levels.
+//
+// Returns:
+//   - cfg (*RecorderConfiguration): A pointer to the default configuration.
+func DefaultRecorderConfig() (cfg *RecorderConfiguration) {
+	cfg = &RecorderConfiguration{
+		Level: hqgologgerlevels.LevelTrace,
+	}
+
+	return
+}
+
+// NewRecorder creates and returns a new Recorder. If no configuration is provided (i.e.,
+// cfg is nil), it uses the default configuration from DefaultRecorderConfig.
+//
+// Parameters:
+//   - cfg (*RecorderConfiguration): The configuration for the recorder. If nil, defaults
+//     are applied.
+//
+// Returns:
+//   - recorder (*Recorder): A pointer to a new Recorder instance.
+func NewRecorder(cfg *RecorderConfiguration) (recorder *Recorder) {
+	if cfg == nil {
+		cfg = DefaultRecorderConfig()
+	}
+
+	recorder = &Recorder{
+		cfg:   cfg,
+		mutex: &sync.Mutex{},
+	}
+
+	return
+}
+
+// New returns a Logger whose events are captured by a new Recorder, for use in tests.
+// The logger writes nothing itself: its events are only captured, at all levels by
+// default. Its level can be changed like that of any Logger (with SetLevel,
+// SetAtomicLevel, or SetLevelRules), which restricts the events captured. If
+// the test fails, the captured events are printed with tb.Log when it finishes, so that
+// logs only clutter the output of failing tests. LevelFatal events do not exit the
+// program; the test goes on, and the event can be asserted on like any other.
+//
+// Parameters:
+//   - tb (testing.TB): The test or benchmark the logger is used in.
+//
+// Returns:
+//   - logger (*hqgologger.Logger): The logger to pass to the code under test.
+//   - recorder (*Recorder): The recorder capturing the logger's events.
+func New(tb testing.TB) (logger *hqgologger.Logger, recorder *Recorder) {
+	tb.Helper()
+
+	recorder = NewRecorder(nil)
+
+	logger = hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelTrace)
+	logger.SetExitFunc(func(_ int) {})
+	logger.AddSink(recorder.Sink())
+
+	tb.Cleanup(func() {
+		if tb.Failed() {
+			recorder.dump(tb)
+		}
+	})
+
+	return
+}
+
+// dump prints the captured entries with tb.Log, formatted by a Console formatter
+// without colors.
+//
+// Parameters:
+//   - tb (testing.TB): The test to print the entries to.
+func (r *Recorder) dump(tb testing.TB) {
+	tb.Helper()
+
+	cfg := hqgologgerformatter.DefaultConsoleConfig()
+
+	cfg.Colorize = false
+	cfg.PrettyPrint = true
+
+	formatter := hqgologgerformatter.NewConsoleFormatter(cfg)
+
+	for _, entry := range r.All() {
+		data, err := formatter.Format(entry.log())
+		if err != nil {
+			continue
+		}
+
+		tb.Log(string(data))
+	}
+}
@@ -0,0 +1,145 @@
+package loggertest_test
+
+import (
+	"reflect"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestNewCapturesAllLevels(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.Trace("trace")
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Fatal("fatal")
+	logger.Info("after fatal")
+
+	want := []string{"trace", "debug", "info", "fatal", "after fatal"}
+
+	if got := recorder.All().Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %q, want %q", got, want)
+	}
+}
+
+func TestNewHonorsLoggerLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		setup func(logger *hqgologger.Logger)
+		log   func(logger *hqgologger.Logger)
+		want  []string
+	}{
+		{
+			name: "set level",
+			setup: func(logger *hqgologger.Logger) {
+				logger.SetLevel(hqgologgerlevels.LevelInfo)
+			},
+			log: func(logger *hqgologger.Logger) {
+				logger.Debug("debug")
+				logger.Info("info")
+			},
+			want: []string{"info"},
+		},
+		{
+			name: "set atomic level",
+			setup: func(logger *hqgologger.Logger) {
+				logger.SetAtomicLevel(hqgologger.NewAtomicLevel(hqgologgerlevels.LevelWarn))
+			},
+			log: func(logger *hqgologger.Logger) {
+				logger.Info("info")
+				logger.Warn("warn")
+			},
+			want: []string{"warn"},
+		},
+		{
+			name: "set level rules",
+			setup: func(logger *hqgologger.Logger) {
+				rules, _ := hqgologger.ParseLevelRules("*=error,y=debug")
+
+				logger.SetLevelRules(rules)
+			},
+			log: func(logger *hqgologger.Logger) {
+				logger.Named("x").Info("x info")
+				logger.Named("x").Error("x error")
+				logger.Named("y").Debug("y debug")
+			},
+			want: []string{"x error", "y debug"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger, recorder := hqgologgerloggertest.New(t)
+
+			test.setup(logger)
+			test.log(logger)
+
+			if got := recorder.All().Messages(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("recorded %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	cfg := hqgologgerloggertest.DefaultRecorderConfig()
+
+	cfg.Level = hqgologgerlevels.LevelInfo
+
+	recorder := hqgologgerloggertest.NewRecorder(cfg)
+
+	logger := hqgologger.NewLogger()
+
+	logger.SetLevel(hqgologgerlevels.LevelTrace)
+	logger.SetCaller(true)
+	logger.AddSink(recorder.Sink())
+
+	logger.Named("api").With(hqgologger.WithString("request_id", "42")).Info("served", hqgologger.WithInt("status", 200))
+	logger.Debug("below the recorder level")
+
+	if got := recorder.Len(); got != 1 {
+		t.Fatalf("Len() = %d, want 1", got)
+	}
+
+	entries := recorder.All()
+
+	entry := entries[0]
+
+	if entry.Level != hqgologgerlevels.LevelInfo || entry.Message != "served" || entry.Name != "api" {
+		t.Errorf("entry = %v %q %q, want info \"served\" \"api\"", entry.Level, entry.Message, entry.Name)
+	}
+
+	if value, _ := entry.Value("request_id"); value != "42" {
+		t.Errorf("Value(\"request_id\") = %v, want 42", value)
+	}
+
+	if entry.Caller == nil || entry.Caller.Line == 0 {
+		t.Errorf("Caller = %v, want the location of the call", entry.Caller)
+	}
+
+	// All returns a copy.
+	entries[0].Message = "changed"
+
+	if got := recorder.All()[0].Message; got != "served" {
+		t.Errorf("All() shares its entries with the recorder: Message = %q", got)
+	}
+
+	if got := recorder.TakeAll(); len(got) != 1 {
+		t.Errorf("TakeAll() returned %d entries, want 1", len(got))
+	}
+
+	if got := recorder.Len(); got != 0 {
+		t.Errorf("Len() after TakeAll() = %d, want 0", got)
+	}
+}
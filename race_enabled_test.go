@@ -0,0 +1,7 @@
+//go:build race
+
+package logger_test
+
+// raceEnabled reports whether the race detector is enabled, which makes sync.Pool drop
+// pooled values at random and so makes allocation counts unreliable.
+const raceEnabled = true
@@ -0,0 +1,574 @@
+package logger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+)
+
+// Redactor masks sensitive data in log events before they reach any formatter (see
+// Logger.SetRedactor). It masks the values of metadata keys matching a configured list
+// of names, values wrapped in Sensitive, and the parts of messages and textual metadata
+// values matching configured patterns (e.g., JWTs, AWS access keys, bearer tokens, or
+// credit card numbers). Masked values are replaced according to the configured mode:
+// fully, partially (keeping the last 4 characters), or with a keyed hash that allows
+// correlating equal values across events without revealing them. A Redactor is
+// immutable once created and is safe for concurrent use.
+//
+// Fields:
+//   - cfg (*RedactorConfiguration): Configuration settings for the redactor, holding its
+//     keys, patterns, and masking mode.
+type Redactor struct {
+	cfg *RedactorConfiguration
+}
+
+// Redact masks the sensitive data in an event's message and metadata in place. Values
+// are masked as a whole if their key matches one of the configured keys, either as a
+// whole or by its last dot-separated segment (e.g., "headers.Authorization" for
+// "authorization"), or if they are wrapped in Sensitive. The configured patterns are
+// applied to the message and to string, byte slice, fmt.Stringer, and error values,
+// masking only the matching text; fmt.Stringer and error values in which text is masked
+// are replaced with the redacted text (e.g., the String of a *url.URL or the Error of
+// an error wrapping one). Maps with string keys, such as http.Header, are redacted by
+// key and value, recursively, into a copy of the map; the logged map is not modified.
+// Other values are left as they are.
+//
+// Parameters:
+//   - event (*Event): The event to redact.
+func (r *Redactor) Redact(event *Event) {
+	event.message = r.RedactString(event.message)
+
+	for i := range event.metadata {
+		field := &event.metadata[i]
+
+		if field.IsNil() {
+			continue
+		}
+
+		if sensitive, ok := field.Value.(Sensitive); ok && field.Kind == hqgologgerformatter.FieldKindAny {
+			*field = r.maskField(field.Key, hqgologgerformatter.Field{Key: field.Key, Value: sensitive.value})
+
+			continue
+		}
+
+		if r.sensitiveKey(field.Key) {
+			*field = r.maskField(field.Key, *field)
+
+			continue
+		}
+
+		switch field.Kind {
+		case hqgologgerformatter.FieldKindString, hqgologgerformatter.FieldKindStringer, hqgologgerformatter.FieldKindBytes:
+			if len(r.cfg.Patterns) == 0 {
+				continue
+			}
+
+			value := string(field.AppendText(nil))
+
+			if redacted := r.RedactString(value); redacted != value {
+				*field = hqgologgerformatter.Field{Key: field.Key, Kind: hqgologgerformatter.FieldKindString, String: redacted}
+			}
+		case hqgologgerformatter.FieldKindAny:
+			redacted, changed := r.redactValue(field.Value)
+			if !changed {
+				continue
+			}
+
+			if str, ok := redacted.(string); ok {
+				*field = hqgologgerformatter.Field{Key: field.Key, Kind: hqgologgerformatter.FieldKindString, String: str}
+
+				continue
+			}
+
+			*field = hqgologgerformatter.Field{Key: field.Key, Value: redacted}
+		}
+	}
+}
+
+// RedactString masks the parts of a string matching the configured patterns. For
+// patterns with capturing groups, only the text of the first group is masked (e.g.,
+// the token following "Bearer "); otherwise the whole match is.
+//
+// Parameters:
+//   - s (string): The string to redact.
+//
+// Returns:
+//   - redacted (string): The string with the matching parts masked.
+func (r *Redactor) RedactString(s string) (redacted string) {
+	redacted = s
+
+	for _, pattern := range r.cfg.Patterns {
+		matches := pattern.FindAllStringSubmatchIndex(redacted, -1)
+		if matches == nil {
+			continue
+		}
+
+		var builder strings.Builder
+
+		last := 0
+
+		for _, match := range matches {
+			start, end := match[0], match[1]
+
+			if len(match) >= 4 && match[2] >= 0 {
+				start, end = match[2], match[3]
+			}
+
+			builder.WriteString(redacted[last:start])
+			builder.WriteString(r.Mask(redacted[start:end]))
+
+			last = end
+		}
+
+		builder.WriteString(redacted[last:])
+
+		redacted = builder.String()
+	}
+
+	return
+}
+
+// Mask masks a sensitive value according to the configured mode.
+//
+// Parameters:
+//   - value (string): The value to mask.
+//
+// Returns:
+//   - masked (string): The masked value.
+func (r *Redactor) Mask(value string) (masked string) {
+	switch r.cfg.Mode {
+	case RedactionModePartial:
+		count := utf8.RuneCountInString(value)
+
+		if count <= _RedactionPartialVisible {
+			masked = r.fullMask()
+
+			return
+		}
+
+		i := len(value)
+
+		for range _RedactionPartialVisible {
+			_, size := utf8.DecodeLastRuneInString(value[:i])
+
+			i -= size
+		}
+
+		masked = strings.Repeat("*", _RedactionPartialVisible) + value[i:]
+	case RedactionModeHash:
+		mac := hmac.New(sha256.New, r.cfg.HashKey)
+
+		mac.Write([]byte(value))
+
+		masked = "sha256:" + hex.EncodeToString(mac.Sum(nil)[:_RedactionHashSize])
+	default:
+		masked = r.fullMask()
+	}
+
+	return
+}
+
+// fullMask returns the configured mask, or "[REDACTED]" if none is configured.
+//
+// Returns:
+//   - mask (string): The mask.
+func (r *Redactor) fullMask() (mask string) {
+	mask = r.cfg.Mask
+
+	if mask == "" {
+		mask = _RedactionMask
+	}
+
+	return
+}
+
+// sensitiveKey reports whether a metadata key, or its last dot-separated segment,
+// matches one of the configured keys, ignoring case.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//
+// Returns:
+//   - sensitive (bool): True if values under the key are masked.
+func (r *Redactor) sensitiveKey(key string) (sensitive bool) {
+	segment := key
+
+	if i := strings.LastIndexByte(key, '.'); i >= 0 {
+		segment = key[i+1:]
+	}
+
+	for _, k := range r.cfg.Keys {
+		if strings.EqualFold(key, k) || strings.EqualFold(segment, k) {
+			sensitive = true
+
+			return
+		}
+	}
+
+	return
+}
+
+// redactValue redacts a metadata value of FieldKindAny: the configured patterns are
+// applied to strings and to the text of errors and fmt.Stringer values, and maps with
+// string keys are redacted by key and value (see _RedactMap).
+//
+// Parameters:
+//   - value (any): The value to redact.
+//
+// Returns:
+//   - redacted (any): The redacted value: the value itself if nothing was masked, the
+//     redacted text for strings, errors, and fmt.Stringer values, and a redacted copy
+//     for maps.
+//   - changed (bool): True if anything was masked.
+func (r *Redactor) redactValue(value any) (redacted any, changed bool) {
+	redacted = value
+
+	var text string
+
+	switch v := value.(type) {
+	case Sensitive:
+		redacted, changed = r.fullMask(), true
+
+		if r.cfg.Mode != RedactionModeFull {
+			redacted = r.Mask(fmt.Sprint(v.value))
+		}
+
+		return
+	case http.Header:
+		redacted, changed = _RedactMap(r, v)
+
+		return
+	case map[string][]string:
+		redacted, changed = _RedactMap(r, v)
+
+		return
+	case map[string]string:
+		redacted, changed = _RedactMap(r, v)
+
+		return
+	case map[string]any:
+		redacted, changed = _RedactMap(r, v)
+
+		return
+	case string:
+		text = v
+	case url.URL:
+		text = v.String()
+	case error:
+		text = v.Error()
+	case fmt.Stringer:
+		text = v.String()
+	default:
+		return
+	}
+
+	if str := r.RedactString(text); str != text {
+		redacted, changed = str, true
+	}
+
+	return
+}
+
+// _RedactMap returns a copy of a map with string keys in which the values under the
+// configured keys are masked as a whole and the other values are redacted as described
+// for redactValue. Masking keeps the type of the values: strings are masked in place,
+// and each string of a []string (e.g., the values of an http.Header) is masked.
+//
+// Parameters:
+//   - r (*Redactor): The redactor.
+//   - m (M): The map to redact. It is not modified.
+//
+// Returns:
+//   - redacted (M): The redacted copy, or m itself if nothing was masked.
+//   - changed (bool): True if anything was masked.
+func _RedactMap[M ~map[string]V, V any](r *Redactor, m M) (redacted M, changed bool) {
+	redacted = make(M, len(m))
+
+	for key, value := range m {
+		var masked any
+
+		var maskedChanged bool
+
+		switch v := any(value).(type) {
+		case string:
+			if r.sensitiveKey(key) {
+				masked, maskedChanged = r.maskText(v), true
+			} else {
+				masked, maskedChanged = r.redactValue(v)
+			}
+		case []string:
+			strs := make([]string, len(v))
+
+			for i, str := range v {
+				if r.sensitiveKey(key) {
+					strs[i] = r.maskText(str)
+				} else {
+					strs[i] = r.RedactString(str)
+				}
+
+				maskedChanged = maskedChanged || strs[i] != str
+			}
+
+			masked = strs
+		default:
+			if r.sensitiveKey(key) && v != nil {
+				masked, maskedChanged = r.maskText(fmt.Sprint(v)), true
+			} else {
+				masked, maskedChanged = r.redactValue(v)
+			}
+		}
+
+		redacted[key] = value
+
+		if maskedChanged {
+			if typed, ok := masked.(V); ok {
+				redacted[key] = typed
+			}
+
+			changed = true
+		}
+	}
+
+	if !changed {
+		redacted = m
+	}
+
+	return
+}
+
+// maskText masks a sensitive value as a whole according to the configured mode.
+//
+// Parameters:
+//   - value (string): The value to mask.
+//
+// Returns:
+//   - masked (string): The masked value.
+func (r *Redactor) maskText(value string) (masked string) {
+	masked = r.fullMask()
+
+	if r.cfg.Mode != RedactionModeFull {
+		masked = r.Mask(value)
+	}
+
+	return
+}
+
+// maskField masks the value of a field as a whole, returning a string field holding
+// the masked value under the given key.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - field (hqgologgerformatter.Field): The field holding the value to mask.
+//
+// Returns:
+//   - masked (hqgologgerformatter.Field): The field holding the masked value.
+func (r *Redactor) maskField(key string, field hqgologgerformatter.Field) (masked hqgologgerformatter.Field) {
+	value := r.fullMask()
+
+	if r.cfg.Mode != RedactionModeFull {
+		value = r.Mask(string(field.AppendText(nil)))
+	}
+
+	masked = hqgologgerformatter.Field{Key: key, Kind: hqgologgerformatter.FieldKindString, String: value}
+
+	return
+}
+
+// Sensitive wraps a value that must never appear in logs as is. Stored as metadata (see
+// WithSensitive), it is masked by the logger's Redactor regardless of its key, and if
+// the logger has none, formatters print it as "[REDACTED]".
+//
+// Fields:
+//   - value (any): The wrapped value.
+type Sensitive struct {
+	value any
+}
+
+// Value returns the wrapped value.
+//
+// Returns:
+//   - value (any): The wrapped value.
+func (s Sensitive) Value() (value any) {
+	value = s.value
+
+	return
+}
+
+// String returns "[REDACTED]", so that the value is not revealed when printed.
+//
+// Returns:
+//   - str (string): The mask.
+func (s Sensitive) String() (str string) {
+	str = _RedactionMask
+
+	return
+}
+
+// MarshalJSON returns "[REDACTED]" as a JSON string, so that the value is not revealed
+// when encoded.
+//
+// Returns:
+//   - data ([]byte): The mask as a JSON string.
+//   - err (error): Always nil.
+func (s Sensitive) MarshalJSON() (data []byte, err error) {
+	data = []byte(`"` + _RedactionMask + `"`)
+
+	return
+}
+
+// NewSensitive wraps a value in Sensitive.
+//
+// Parameters:
+//   - value (any): The value to wrap.
+//
+// Returns:
+//   - sensitive (Sensitive): The wrapped value.
+func NewSensitive(value any) (sensitive Sensitive) {
+	sensitive = Sensitive{
+		value: value,
+	}
+
+	return
+}
+
+// WithSensitive returns an OptionFunc that adds a value wrapped in Sensitive to a log
+// event's metadata, so that it is masked regardless of its key.
+//
+// Parameters:
+//   - key (string): The metadata key.
+//   - value (any): The sensitive value.
+//
+// Returns:
+//   - (OptionFunc): A function to configure the event's metadata with a sensitive value.
+func WithSensitive(key string, value any) OptionFunc {
+	return func(event *Event) {
+		event.SetValue(key, NewSensitive(value))
+	}
+}
+
+// RedactionMode defines how a Redactor masks sensitive values.
+type RedactionMode int
+
+const (
+	// RedactionModeFull replaces sensitive values with the configured mask.
+	RedactionModeFull RedactionMode = iota
+	// RedactionModePartial keeps the last 4 characters of sensitive values, replacing
+	// the rest with "****" (e.g., "****1234"). Values of 4 characters or fewer are
+	// replaced with the configured mask.
+	RedactionModePartial
+	// RedactionModeHash replaces sensitive values with a truncated HMAC-SHA256 of the
+	// value, keyed with the configured hash key (e.g., "sha256:9f86d081884c7d65"), so
+	// that equal values can be correlated across events.
+	RedactionModeHash
+)
+
+// RedactorConfiguration defines configuration options for a Redactor.
+//
+// Fields:
+//   - Keys ([]string): The metadata keys whose values are masked, compared ignoring case.
+//   - Patterns ([]*regexp.Regexp): The patterns masked in messages and textual metadata
+//     values. For patterns with capturing groups, only the first group is masked.
+//   - Mode (RedactionMode): How sensitive values are masked.
+//   - Mask (string): The replacement used by RedactionModeFull, and by
+//     RedactionModePartial for short values. If empty, "[REDACTED]" is used.
+//   - HashKey ([]byte): The key used by RedactionModeHash. It should be kept secret, as
+//     anyone holding it can test guesses against the hashes.
+type RedactorConfiguration struct {
+	Keys     []string
+	Patterns []*regexp.Regexp
+	Mode     RedactionMode
+	Mask     string
+	HashKey  []byte
+}
+
+const (
+	// _RedactionMask is the default mask, also printed for Sensitive values.
+	_RedactionMask = "[REDACTED]"
+	// _RedactionPartialVisible is the number of trailing characters kept by
+	// RedactionModePartial.
+	_RedactionPartialVisible = 4
+	// _RedactionHashSize is the number of bytes of the HMAC kept by RedactionModeHash.
+	_RedactionHashSize = 8
+)
+
+// DefaultRedactionKeys returns the metadata keys masked by default: common names of
+// passwords, secrets, tokens, API keys, and credential-bearing HTTP headers.
+//
+// Returns:
+//   - keys ([]string): The default keys.
+func DefaultRedactionKeys() (keys []string) {
+	keys = []string{
+		"password", "passwd", "secret", "client_secret", "token", "access_token",
+		"refresh_token", "api_key", "apikey", "api-key", "x-api-key", "private_key",
+		"authorization", "proxy-authorization", "cookie", "set-cookie",
+	}
+
+	return
+}
+
+// DefaultRedactionPatterns returns the patterns masked by default: JSON Web Tokens, AWS
+// access key IDs, bearer tokens, credentials in URLs (user information and common query
+// parameters), and credit card numbers.
+//
+// Returns:
+//   - patterns ([]*regexp.Regexp): The default patterns.
+func DefaultRedactionPatterns() (patterns []*regexp.Regexp) {
+	patterns = []*regexp.Regexp{
+		regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+		regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+		regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`),
+		regexp.MustCompile(`://[^/\s:@]+:([^/\s@]+)@`),
+		regexp.MustCompile(`(?i)[?&](?:access_token|api_key|apikey|password|secret|token)=([^&\s#]+)`),
+		regexp.MustCompile(`\b(?:4\d{3}|5[1-5]\d{2}|6(?:011|5\d{2}))(?:[ -]?\d{4}){3}\b|\b3[47]\d{2}[ -]?\d{6}[ -]?\d{5}\b`),
+	}
+
+	return
+}
+
+// DefaultRedactorConfig returns a default configuration for a Redactor. The default
+// settings mask the keys from DefaultRedactionKeys and the patterns from
+// DefaultRedactionPatterns fully, with "[REDACTED]".
+//
+// Returns:
+//   - cfg (*RedactorConfiguration): A pointer to the default configuration.
+func DefaultRedactorConfig() (cfg *RedactorConfiguration) {
+	cfg = &RedactorConfiguration{
+		Keys:     DefaultRedactionKeys(),
+		Patterns: DefaultRedactionPatterns(),
+		Mode:     RedactionModeFull,
+		Mask:     _RedactionMask,
+		HashKey:  nil,
+	}
+
+	return
+}
+
+// NewRedactor creates and returns a new Redactor. If no configuration is provided (i.e.,
+// cfg is nil), it uses the default configuration from DefaultRedactorConfig. The
+// configuration must not be modified after the redactor is created.
+//
+// Parameters:
+//   - cfg (*RedactorConfiguration): The configuration for the redactor. If nil, defaults
+//     are applied.
+//
+// Returns:
+//   - redactor (*Redactor): A pointer to a new Redactor instance.
+func NewRedactor(cfg *RedactorConfiguration) (redactor *Redactor) {
+	if cfg == nil {
+		cfg = DefaultRedactorConfig()
+	}
+
+	redactor = &Redactor{
+		cfg: cfg,
+	}
+
+	return
+}
@@ -0,0 +1,222 @@
+package logger
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// LevelRules sets the level threshold of named loggers (see Logger.Named and
+// Logger.SetLevelRules) per component, so that, e.g., debug output can be enabled for a
+// single subsystem. Rules are written as comma-separated "name=level" pairs, such as
+// "http=debug,http.client=warn,*=info". A rule applies to the logger of its name and to
+// the loggers named below it (e.g., "http" applies to "http.server"), the most specific
+// rule winning, and the rule "*" applies to all loggers no other rule applies to. A
+// level without a name (e.g., "debug") is short for "*=debug".
+//
+// When used by a Logger (see Logger.SetLevelRules), the rules override the logger's
+// level, which is left unchanged: the loggers no rule applies to, i.e., all of them but
+// the named ones if there is no rule "*", keep following the logger's AtomicLevel, which
+// can then still be changed at runtime (e.g., with AtomicLevel.ServeHTTP or
+// AtomicLevel.ToggleDebug).
+//
+// LevelRules implements flag.Value, so rules can be read from a command-line flag with
+// flag.Var, and can be changed at any time with Set. LevelRules are safe for concurrent
+// use and are read without locking. The zero value has no rules.
+//
+// Fields:
+//   - set (atomic.Pointer[_LevelRuleSet]): The rules as last set, replaced as a whole by
+//     Set.
+type LevelRules struct {
+	set atomic.Pointer[_LevelRuleSet]
+}
+
+// _LevelRuleSet is an immutable set of level rules.
+//
+// Fields:
+//   - spec (string): The rules as set, returned by LevelRules.String.
+//   - levels (map[string]hqgologgerlevels.Level): The level of each rule, keyed by name.
+type _LevelRuleSet struct {
+	spec   string
+	levels map[string]hqgologgerlevels.Level
+}
+
+// Level resolves the level of the logger with the given name: the level of the rule
+// for the name itself, or else for its closest parent (e.g., "http" for "http.client"),
+// or else of the rule "*".
+//
+// Parameters:
+//   - name (string): The name of the logger, dot-separated.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The level of the rule applying to the name.
+//   - ok (bool): True if a rule applies to the name.
+func (r *LevelRules) Level(name string) (level hqgologgerlevels.Level, ok bool) {
+	set := r.set.Load()
+	if set == nil {
+		return
+	}
+
+	if level, ok = set.match(name); ok {
+		return
+	}
+
+	level, ok = set.levels["*"]
+
+	return
+}
+
+// Default returns the level of the rule "*", which applies to the loggers no other rule
+// applies to.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The level of the rule "*".
+//   - ok (bool): True if the rules have a rule "*".
+func (r *LevelRules) Default() (level hqgologgerlevels.Level, ok bool) {
+	if set := r.set.Load(); set != nil {
+		level, ok = set.levels["*"]
+	}
+
+	return
+}
+
+// match resolves the level of the named rule applying to the logger with the given
+// name, ignoring the rule "*": the level of the rule for the name itself, or else for
+// its closest parent. It works on a set loaded once by Level, so that a concurrent Set
+// cannot mix the rules of two specs.
+//
+// Parameters:
+//   - name (string): The name of the logger, dot-separated.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The level of the rule applying to the name.
+//   - ok (bool): True if a named rule applies to the name.
+func (set *_LevelRuleSet) match(name string) (level hqgologgerlevels.Level, ok bool) {
+	for name != "" {
+		if level, ok = set.levels[name]; ok {
+			return
+		}
+
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			break
+		}
+
+		name = name[:i]
+	}
+
+	return
+}
+
+// Set replaces the rules with the ones parsed from spec. If spec is invalid, the rules
+// are left unchanged. Changes take effect immediately for the loggers using the rules
+// (see Logger.SetLevelRules).
+//
+// Parameters:
+//   - spec (string): The rules, as comma-separated "name=level" pairs.
+//
+// Returns:
+//   - err (error): An error wrapping ErrInvalidLevelRule if a rule is malformed or
+//     names an unknown level, otherwise nil.
+func (r *LevelRules) Set(spec string) (err error) {
+	levels := make(map[string]hqgologgerlevels.Level)
+
+	for rule := range strings.SplitSeq(spec, ",") {
+		rule = strings.TrimSpace(rule)
+
+		if rule == "" {
+			continue
+		}
+
+		name, text, found := strings.Cut(rule, "=")
+		if !found {
+			name, text = "*", name
+		}
+
+		name = strings.TrimSpace(name)
+
+		if name == "" {
+			err = fmt.Errorf("%w: %q: empty name", ErrInvalidLevelRule, rule)
+
+			return
+		}
+
+		var level hqgologgerlevels.Level
+
+		if err = level.UnmarshalText([]byte(strings.ToLower(strings.TrimSpace(text)))); err != nil {
+			err = fmt.Errorf("%w: %q: %w", ErrInvalidLevelRule, rule, err)
+
+			return
+		}
+
+		levels[name] = level
+	}
+
+	r.set.Store(&_LevelRuleSet{
+		spec:   spec,
+		levels: levels,
+	})
+
+	return
+}
+
+// String returns the rules as last set.
+//
+// Returns:
+//   - spec (string): The rules, as comma-separated "name=level" pairs.
+func (r *LevelRules) String() (spec string) {
+	if r == nil {
+		return
+	}
+
+	if set := r.set.Load(); set != nil {
+		spec = set.spec
+	}
+
+	return
+}
+
+// ErrInvalidLevelRule is an error returned when parsing level rules if a rule is
+// malformed or names an unknown level.
+var ErrInvalidLevelRule = errors.New("invalid level rule")
+
+var _ flag.Value = (*LevelRules)(nil)
+
+// NewLevelRules creates and returns new LevelRules without any rule, to be set with Set
+// (e.g., by flag.Var).
+//
+// Returns:
+//   - rules (*LevelRules): A pointer to new LevelRules.
+func NewLevelRules() (rules *LevelRules) {
+	rules = &LevelRules{}
+
+	rules.set.Store(&_LevelRuleSet{
+		levels: make(map[string]hqgologgerlevels.Level),
+	})
+
+	return
+}
+
+// ParseLevelRules creates and returns new LevelRules parsed from spec (e.g., the value
+// of an environment variable), as described for LevelRules.
+//
+// Parameters:
+//   - spec (string): The rules, as comma-separated "name=level" pairs.
+//
+// Returns:
+//   - rules (*LevelRules): A pointer to the parsed LevelRules.
+//   - err (error): An error wrapping ErrInvalidLevelRule if a rule is malformed or
+//     names an unknown level, otherwise nil.
+func ParseLevelRules(spec string) (rules *LevelRules, err error) {
+	rules = NewLevelRules()
+
+	if err = rules.Set(spec); err != nil {
+		rules = nil
+	}
+
+	return
+}
@@ -0,0 +1,170 @@
+package logger_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestLevelRulesLevel(t *testing.T) {
+	t.Parallel()
+
+	rules, err := hqgologger.ParseLevelRules("http=debug, http.client=WARN, db.pool=trace, *=error")
+	if err != nil {
+		t.Fatalf("ParseLevelRules() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		want hqgologgerlevels.Level
+	}{
+		{name: "http", want: hqgologgerlevels.LevelDebug},
+		{name: "http.server", want: hqgologgerlevels.LevelDebug},
+		{name: "http.client", want: hqgologgerlevels.LevelWarn},
+		{name: "http.client.dialer", want: hqgologgerlevels.LevelWarn},
+		{name: "httpx", want: hqgologgerlevels.LevelError},
+		{name: "db", want: hqgologgerlevels.LevelError},
+		{name: "db.pool", want: hqgologgerlevels.LevelTrace},
+		{name: "", want: hqgologgerlevels.LevelError},
+	}
+
+	for _, test := range tests {
+		if got, ok := rules.Level(test.name); !ok || got != test.want {
+			t.Errorf("Level(%q) = %v, %v, want %v, true", test.name, got, ok, test.want)
+		}
+	}
+}
+
+func TestLevelRulesSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		spec    string
+		want    hqgologgerlevels.Level
+		wantErr bool
+	}{
+		{spec: "debug", want: hqgologgerlevels.LevelDebug},
+		{spec: "http=info,", want: hqgologgerlevels.LevelInfo},
+		{spec: "http=verbose", wantErr: true},
+		{spec: "=debug", wantErr: true},
+	}
+
+	for _, test := range tests {
+		var rules hqgologger.LevelRules
+
+		err := rules.Set(test.spec)
+
+		if test.wantErr {
+			if !errors.Is(err, hqgologger.ErrInvalidLevelRule) {
+				t.Errorf("Set(%q) error = %v, want %v", test.spec, err, hqgologger.ErrInvalidLevelRule)
+			}
+
+			if _, ok := rules.Level("http"); ok || rules.String() != "" {
+				t.Errorf("Set(%q) changed the rules to %q", test.spec, rules.String())
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Set(%q) error = %v", test.spec, err)
+		}
+
+		if got, ok := rules.Level("http"); !ok || got != test.want {
+			t.Errorf("Set(%q): Level(\"http\") = %v, %v, want %v, true", test.spec, got, ok, test.want)
+		}
+
+		if got := rules.String(); got != test.spec {
+			t.Errorf("String() = %q, want %q", got, test.spec)
+		}
+	}
+}
+
+func TestLoggerLevelRules(t *testing.T) {
+	t.Parallel()
+
+	recorder := hqgologgerloggertest.NewRecorder(nil)
+
+	level := hqgologger.NewAtomicLevel(hqgologgerlevels.LevelDebug)
+
+	logger := hqgologger.NewLogger()
+
+	logger.SetAtomicLevel(level)
+	logger.AddSink(recorder.Sink())
+
+	rules, err := hqgologger.ParseLevelRules("http=debug,http.client=warn,*=error")
+	if err != nil {
+		t.Fatalf("ParseLevelRules() error = %v", err)
+	}
+
+	logger.SetLevelRules(rules)
+
+	// The rule "*" is resolved per event: the shared AtomicLevel is left unchanged.
+	if got := level.Level(); got != hqgologgerlevels.LevelDebug {
+		t.Fatalf("AtomicLevel().Level() = %v, want %v", got, hqgologgerlevels.LevelDebug)
+	}
+
+	server := logger.Named("http").Named("server")
+	client := logger.Named("http").Named("client")
+	db := logger.Named("db")
+
+	log := func() {
+		for _, l := range []*hqgologger.Logger{logger, server, client, db} {
+			l.Debug(l.Name() + " debug")
+			l.Warn(l.Name() + " warn")
+			l.Error(l.Name() + " error")
+		}
+	}
+
+	log()
+
+	want := []string{" error", "http.server debug", "http.server warn", "http.server error", "http.client warn", "http.client error", "db error"}
+
+	if got := recorder.TakeAll().Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %q, want %q", got, want)
+	}
+
+	// Rules changed with Set, including the rule "*", take effect immediately.
+	if err := rules.Set("http.client=trace,*=warn"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	if !client.Enabled(hqgologgerlevels.LevelTrace) || server.Enabled(hqgologgerlevels.LevelDebug) {
+		t.Error("Set() did not take effect for the named loggers")
+	}
+
+	if !db.Enabled(hqgologgerlevels.LevelWarn) || db.Enabled(hqgologgerlevels.LevelInfo) {
+		t.Error("Set() did not take effect for the rule \"*\"")
+	}
+
+	// Without a rule "*", the loggers no named rule applies to follow the AtomicLevel
+	// and its changes (e.g., over HTTP).
+	if err := rules.Set("http=error"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	level.SetLevel(hqgologgerlevels.LevelInfo)
+
+	log()
+
+	want = []string{" warn", " error", "http.server error", "http.client error", "db warn", "db error"}
+
+	if got := recorder.TakeAll().Messages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %q, want %q", got, want)
+	}
+
+	// Removing the rules restores the logger's level.
+	if err := rules.Set("*=error"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	logger.SetLevelRules(nil)
+
+	if !db.Enabled(hqgologgerlevels.LevelInfo) || !client.Enabled(hqgologgerlevels.LevelInfo) || client.Enabled(hqgologgerlevels.LevelDebug) {
+		t.Error("SetLevelRules(nil) did not restore the logger's level")
+	}
+}
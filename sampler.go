@@ -0,0 +1,296 @@
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// Sampler limits the volume of repetitive events (see Logger.SetSampler). Events are
+// grouped by level and message: within each time window, the first events of a group
+// are logged, and after that only every Nth, the others being dropped. A summary event
+// reporting how many events of a group were dropped can be logged when the group's
+// window closes, at the latest one window after the first dropped event, and when the
+// logger is synced or closed. LevelFatal events are never sampled.
+//
+// Groups are tracked in a fixed number of counters selected by hashing the level and
+// message, so memory use is bounded however many distinct messages are logged and
+// sampling does not allocate. Two groups sharing a counter reset each other's window,
+// which lets their events through rather than dropping them. A Sampler is safe for
+// concurrent use.
+//
+// Fields:
+//   - cfg (*SamplerConfiguration): Configuration settings for the sampler, holding its
+//     window and rates.
+//   - counters ([_SamplerCounters]_SampleCounter): The counters of the event groups.
+//   - dropped (atomic.Uint64): The total number of events dropped.
+//   - mutex (*sync.Mutex): Guards the timer.
+//   - timer (*time.Timer): Logs the pending summaries one window after an event is
+//     dropped, or nil if none is pending.
+type Sampler struct {
+	cfg      *SamplerConfiguration
+	counters [_SamplerCounters]_SampleCounter
+	dropped  atomic.Uint64
+	mutex    *sync.Mutex
+	timer    *time.Timer
+}
+
+// Dropped returns the total number of events dropped by the sampler.
+//
+// Returns:
+//   - count (uint64): The number of events dropped.
+func (s *Sampler) Dropped() (count uint64) {
+	count = s.dropped.Load()
+
+	return
+}
+
+// Flush logs the summaries of the events dropped since the last summaries, including
+// those dropped in windows that are still open, if summaries are enabled. Sampling goes
+// on unaffected. It is called one window after an event is dropped and when the logger
+// is synced or closed.
+func (s *Sampler) Flush() {
+	if !s.cfg.Summary {
+		return
+	}
+
+	s.mutex.Lock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+
+		s.timer = nil
+	}
+
+	s.mutex.Unlock()
+
+	for i := range s.counters {
+		counter := &s.counters[i]
+
+		counter.mutex.Lock()
+
+		summary := _SampleSummary{
+			logger:  counter.logger,
+			level:   counter.level,
+			message: counter.message,
+			dropped: counter.dropped,
+		}
+
+		counter.dropped = 0
+
+		counter.mutex.Unlock()
+
+		summary.report()
+	}
+}
+
+// sample counts an event and decides whether it is logged. If the event starts a new
+// window for its counter and events were dropped in the previous one, the dropped
+// events are reported in summary.
+//
+// Parameters:
+//   - logger (*Logger): The logger the event is logged with, used to log the pending
+//     summaries on Flush.
+//   - level (hqgologgerlevels.Level): The level of the event.
+//   - message (string): The message of the event.
+//   - now (time.Time): The current time.
+//
+// Returns:
+//   - keep (bool): True if the event is logged.
+//   - summary (_SampleSummary): The events dropped in the previous window of the
+//     counter, with a zero count if there are none to report.
+func (s *Sampler) sample(logger *Logger, level hqgologgerlevels.Level, message string, now time.Time) (keep bool, summary _SampleSummary) {
+	if level == hqgologgerlevels.LevelFatal {
+		keep = true
+
+		return
+	}
+
+	counter := &s.counters[_SampleHash(level, message)%_SamplerCounters]
+
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+
+	if counter.level != level || counter.message != message || !now.Before(counter.start.Add(s.cfg.Window)) {
+		if s.cfg.Summary && counter.dropped > 0 {
+			summary = _SampleSummary{
+				logger:  counter.logger,
+				level:   counter.level,
+				message: counter.message,
+				dropped: counter.dropped,
+			}
+		}
+
+		counter.level, counter.message, counter.start = level, message, now
+		counter.count, counter.dropped = 0, 0
+	}
+
+	counter.count++
+
+	first := uint64(max(s.cfg.First, 0))
+
+	keep = counter.count <= first || (s.cfg.Thereafter > 0 && (counter.count-first)%uint64(s.cfg.Thereafter) == 0)
+
+	if !keep {
+		counter.dropped++
+		counter.logger = logger
+
+		s.dropped.Add(1)
+
+		if s.cfg.Summary && counter.dropped == 1 {
+			s.schedule()
+		}
+	}
+
+	return
+}
+
+// schedule starts the timer calling Flush one window later, unless it is already
+// running.
+func (s *Sampler) schedule() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.timer == nil {
+		s.timer = time.AfterFunc(s.cfg.Window, s.Flush)
+	}
+}
+
+// report logs the summary of the events of a group dropped by the sampler, with the
+// logger the last of them was logged with, if any were dropped.
+func (s _SampleSummary) report() {
+	if s.dropped == 0 || s.logger == nil {
+		return
+	}
+
+	pipeline := s.logger.core.pipeline(s.logger.name)
+
+	s.logger.report(&pipeline, s.level, "Sampled messages dropped",
+		WithString("sampled_message", s.message), WithInt64("dropped", int64(s.dropped)))
+}
+
+// _SampleCounter counts the events of a group within the current window.
+//
+// Fields:
+//   - mutex (sync.Mutex): Serializes access to the counter.
+//   - level (hqgologgerlevels.Level): The level of the group.
+//   - message (string): The message of the group.
+//   - start (time.Time): The start of the current window.
+//   - count (uint64): The number of events in the current window.
+//   - dropped (uint64): The number of events dropped in the current window and not yet
+//     reported.
+//   - logger (*Logger): The logger the last dropped event was logged with, used to log
+//     the summary on Flush.
+type _SampleCounter struct {
+	mutex   sync.Mutex
+	level   hqgologgerlevels.Level
+	message string
+	start   time.Time
+	count   uint64
+	dropped uint64
+	logger  *Logger
+}
+
+// _SampleSummary reports the events of a group dropped in a closed window.
+//
+// Fields:
+//   - logger (*Logger): The logger the last dropped event was logged with.
+//   - level (hqgologgerlevels.Level): The level of the group.
+//   - message (string): The message of the group.
+//   - dropped (uint64): The number of events dropped.
+type _SampleSummary struct {
+	logger  *Logger
+	level   hqgologgerlevels.Level
+	message string
+	dropped uint64
+}
+
+// _SampleHash computes the FNV-1a hash of a level and message.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The level of the event.
+//   - message (string): The message of the event.
+//
+// Returns:
+//   - hash (uint32): The hash.
+func _SampleHash(level hqgologgerlevels.Level, message string) (hash uint32) {
+	const prime = 16777619
+
+	hash = 2166136261
+
+	hash = (hash ^ uint32(level)) * prime
+
+	for i := range len(message) {
+		hash = (hash ^ uint32(message[i])) * prime
+	}
+
+	return
+}
+
+// _SamplerCounters is the number of counters of a Sampler.
+const _SamplerCounters = 1024
+
+// SamplerConfiguration defines configuration options for a Sampler.
+//
+// Fields:
+//   - Window (time.Duration): The length of the time window the events of a group are
+//     counted in.
+//   - First (int): The number of events of a group logged at the start of each window.
+//     If zero or negative, none are.
+//   - Thereafter (int): After the first events, every Thereafter-th event of a group is
+//     logged within the window. If zero or negative, all of them are dropped.
+//   - Summary (bool): If true, a summary event is logged at the level of a group when
+//     its window closes with events dropped, with the message "Sampled messages dropped"
+//     and the metadata "sampled_message" (the group's message) and "dropped" (the number
+//     of events dropped). The summary is logged with the next event of the group (or of
+//     a group sharing its counter) once the window has closed, and, for groups not
+//     logged again, by a timer one window after the first dropped event, as well as
+//     when the logger is synced or closed (see Flush).
+type SamplerConfiguration struct {
+	Window     time.Duration
+	First      int
+	Thereafter int
+	Summary    bool
+}
+
+// DefaultSamplerConfig returns a default configuration for a Sampler. The default
+// settings log the first 100 events of each group per second and every 100th after
+// that, and log summaries of dropped events.
+//
+// Returns:
+//   - cfg (*SamplerConfiguration): A pointer to the default configuration.
+func DefaultSamplerConfig() (cfg *SamplerConfiguration) {
+	cfg = &SamplerConfiguration{
+		Window:     time.Second,
+		First:      100,
+		Thereafter: 100,
+		Summary:    true,
+	}
+
+	return
+}
+
+// NewSampler creates and returns a new Sampler. If no configuration is provided (i.e.,
+// cfg is nil), it uses the default configuration from DefaultSamplerConfig. The
+// configuration must not be modified after the sampler is created.
+//
+// Parameters:
+//   - cfg (*SamplerConfiguration): The configuration for the sampler. If nil, defaults
+//     are applied.
+//
+// Returns:
+//   - sampler (*Sampler): A pointer to a new Sampler instance.
+func NewSampler(cfg *SamplerConfiguration) (sampler *Sampler) {
+	if cfg == nil {
+		cfg = DefaultSamplerConfig()
+	}
+
+	sampler = &Sampler{
+		cfg:   cfg,
+		mutex: &sync.Mutex{},
+	}
+
+	return
+}
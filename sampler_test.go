@@ -0,0 +1,155 @@
+package logger_test
+
+import (
+	"testing"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+// sampledSummaries returns the number of events reported dropped by the sampler
+// summaries captured by the recorder, and the number of summaries.
+func sampledSummaries(recorder *hqgologgerloggertest.Recorder) (dropped int64, summaries int) {
+	for _, entry := range recorder.FilterMessage("Sampled messages dropped") {
+		value, _ := entry.Value("dropped")
+
+		count, _ := value.(int64)
+
+		dropped += count
+		summaries++
+	}
+
+	return
+}
+
+func TestSamplerSummaryFlush(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		flush func(logger *hqgologger.Logger)
+	}{
+		{
+			name: "sync",
+			flush: func(logger *hqgologger.Logger) {
+				_ = logger.Sync()
+			},
+		},
+		{
+			name: "close",
+			flush: func(logger *hqgologger.Logger) {
+				_ = logger.Close()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger, recorder := hqgologgerloggertest.New(t)
+
+			logger.SetSampler(hqgologger.NewSampler(&hqgologger.SamplerConfiguration{
+				Window:     time.Hour,
+				First:      1,
+				Thereafter: 0,
+				Summary:    true,
+			}))
+
+			for range 5 {
+				logger.Info("hot loop")
+			}
+
+			if got := len(recorder.FilterMessage("hot loop")); got != 1 {
+				t.Fatalf("logged %d events, want 1", got)
+			}
+
+			test.flush(logger)
+
+			if dropped, summaries := sampledSummaries(recorder); dropped != 4 || summaries != 1 {
+				t.Errorf("got %d summaries of %d dropped events, want 1 of 4", summaries, dropped)
+			}
+
+			// Flushing again reports nothing new.
+			test.flush(logger)
+
+			if _, summaries := sampledSummaries(recorder); summaries != 1 {
+				t.Errorf("got %d summaries after flushing again, want 1", summaries)
+			}
+		})
+	}
+}
+
+func TestSamplerSummaryTimer(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetSampler(hqgologger.NewSampler(&hqgologger.SamplerConfiguration{
+		Window:     10 * time.Millisecond,
+		First:      1,
+		Thereafter: 0,
+		Summary:    true,
+	}))
+
+	for range 3 {
+		logger.Info("hot loop")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		if dropped, _ := sampledSummaries(recorder); dropped == 2 {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("no summary logged by the timer, got %q", recorder.All().Messages())
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSamplerSummaryLogger(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	clock := hqgologgerloggertest.NewClock(time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC), 0)
+
+	logger.SetClock(clock)
+	logger.SetSampler(hqgologger.NewSampler(&hqgologger.SamplerConfiguration{
+		Window:     time.Hour,
+		First:      1,
+		Thereafter: 0,
+		Summary:    true,
+	}))
+
+	worker := logger.Named("worker").With(hqgologger.WithString("component", "queue"))
+
+	for range 3 {
+		worker.Info("hot loop")
+	}
+
+	// The next event starts a new window, which reports the events dropped in the
+	// previous one.
+	clock.Add(2 * time.Hour)
+
+	logger.Named("api").Info("hot loop")
+
+	summaries := recorder.FilterMessage("Sampled messages dropped")
+
+	if len(summaries) != 1 {
+		t.Fatalf("logged %d summaries, want 1", len(summaries))
+	}
+
+	if got := summaries[0].Name; got != "worker" {
+		t.Errorf("summary logged by %q, want \"worker\"", got)
+	}
+
+	if value, _ := summaries[0].Value("component"); value != "queue" {
+		t.Errorf("summary component = %v, want \"queue\"", value)
+	}
+}
@@ -0,0 +1,155 @@
+package logger
+
+import (
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+// Sink is an independent output pipeline of a Logger, combining a formatter, a writer,
+// a minimum severity level, and an optional filter. A Logger passes every event to each
+// of its sinks (see Logger.AddSink), and each sink formats the event on its own, which
+// allows, for example, colorized console output on the terminal and JSON in a file at
+// the same time. Unlike writer.MultiWriter, which fans out already formatted bytes,
+// sinks may use different formatters and levels. Only events within the logger's level
+// threshold reach the sinks, so a sink's level can restrict the events it writes but not
+// widen them beyond the logger's level. A Sink is immutable once created and
+// is safe for concurrent use as long as its formatter and writer are.
+//
+// Fields:
+//   - cfg (*SinkConfiguration): Configuration settings for the sink, holding its
+//     formatter, writer, level, and filter.
+type Sink struct {
+	cfg *SinkConfiguration
+}
+
+// Enabled reports whether the sink accepts events at the given level, that is, whether
+// it has a formatter and a writer and the level is within its threshold. The filter is
+// not consulted, as it needs the full log.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The severity level to check.
+//
+// Returns:
+//   - enabled (bool): True if events at the level would be written.
+func (s *Sink) Enabled(level hqgologgerlevels.Level) (enabled bool) {
+	enabled = s.cfg.Formatter != nil && s.cfg.Writer != nil && level <= s.cfg.Level
+
+	return
+}
+
+// Write formats the log with the sink's formatter and writes the result to its writer,
+// unless the level is not enabled or the filter rejects the log.
+//
+// Parameters:
+//   - log (*hqgologgerformatter.Log): The log to write. It is not modified.
+//
+// Returns:
+//   - err (error): An error if formatting or writing fails, otherwise nil.
+func (s *Sink) Write(log *hqgologgerformatter.Log) (err error) {
+	if !s.Enabled(log.Level) {
+		return
+	}
+
+	if s.cfg.Filter != nil && !s.cfg.Filter(log) {
+		return
+	}
+
+	err = _FormatAndWrite(s.cfg.Formatter, s.cfg.Writer, log)
+
+	return
+}
+
+// Flush flushes the sink's writer if it implements hqgologgerwriter.Flusher.
+//
+// Returns:
+//   - err (error): An error if flushing fails, otherwise nil.
+func (s *Sink) Flush() (err error) {
+	if flusher, ok := s.cfg.Writer.(hqgologgerwriter.Flusher); ok {
+		err = flusher.Flush()
+	}
+
+	return
+}
+
+// Close closes the sink's writer.
+//
+// Returns:
+//   - err (error): An error if closing fails, otherwise nil.
+func (s *Sink) Close() (err error) {
+	if s.cfg.Writer != nil {
+		err = s.cfg.Writer.Close()
+	}
+
+	return
+}
+
+// SinkFilter decides whether a sink writes a log that is within its level threshold,
+// allowing selection on the message or metadata (e.g., only events carrying an "audit"
+// key).
+//
+// Parameters:
+//   - log (*hqgologgerformatter.Log): The log to decide on. It must not be modified or
+//     retained after the filter returns, as the logger reuses it.
+//
+// Returns:
+//   - keep (bool): True if the log should be written.
+type SinkFilter func(log *hqgologgerformatter.Log) (keep bool)
+
+// SinkConfiguration defines configuration options for a Sink.
+//
+// Fields:
+//   - Formatter (hqgologgerformatter.Formatter): The formatter converting events to bytes.
+//   - Writer (hqgologgerwriter.Writer): The writer the formatted events are written to.
+//   - Level (hqgologgerlevels.Level): The minimum severity level written by the sink
+//     (inclusive). Events with a higher level value (less severe) are skipped. It applies
+//     on top of the level of the logger the sink is added to.
+//   - Filter (SinkFilter): An optional filter applied to events within the level
+//     threshold. If nil, all of them are written.
+type SinkConfiguration struct {
+	Formatter hqgologgerformatter.Formatter
+	Writer    hqgologgerwriter.Writer
+	Level     hqgologgerlevels.Level
+	Filter    SinkFilter
+}
+
+var _ hqgologgerwriter.Flusher = (*Sink)(nil)
+
+// DefaultSinkConfig returns a default configuration for a Sink. The default settings
+// use the default Console formatter and Console writer, write all the levels the logger
+// accepts, and apply no filter.
+//
+// Returns:
+//   - cfg (*SinkConfiguration): A pointer to the default configuration.
+func DefaultSinkConfig() (cfg *SinkConfiguration) {
+	cfg = &SinkConfiguration{
+		Formatter: hqgologgerformatter.NewConsoleFormatter(hqgologgerformatter.DefaultConsoleConfig()),
+		Writer:    hqgologgerwriter.NewConsoleWriter(hqgologgerwriter.DefaultConsoleWriterConfig()),
+		Level:     hqgologgerlevels.LevelTrace,
+		Filter:    nil,
+	}
+
+	return
+}
+
+// NewSink creates and returns a new Sink. If no configuration is provided (i.e., cfg is
+// nil), it uses the default configuration from DefaultSinkConfig. The configuration
+// must not be modified after the sink is created.
+//
+// Parameters:
+//   - cfg (*SinkConfiguration): The configuration for the sink. If nil, defaults are
+//     applied.
+//
+// Returns:
+//   - sink (*Sink): A pointer to a new Sink instance.
+func NewSink(cfg *SinkConfiguration) (sink *Sink) {
+	if cfg == nil {
+		cfg = DefaultSinkConfig()
+	}
+
+	sink = &Sink{
+		cfg: cfg,
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+package logger_test
+
+import (
+	"reflect"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestSinkLevels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		loggerLevel hqgologgerlevels.Level
+		sinkLevel   hqgologgerlevels.Level
+		want        []string
+	}{
+		{
+			name:        "logger level restricts sink",
+			loggerLevel: hqgologgerlevels.LevelInfo,
+			sinkLevel:   hqgologgerlevels.LevelTrace,
+			want:        []string{"error", "warn", "info"},
+		},
+		{
+			name:        "sink level restricts further",
+			loggerLevel: hqgologgerlevels.LevelDebug,
+			sinkLevel:   hqgologgerlevels.LevelWarn,
+			want:        []string{"error", "warn"},
+		},
+		{
+			name:        "default logger level",
+			loggerLevel: hqgologgerlevels.LevelFatal,
+			sinkLevel:   hqgologgerlevels.LevelTrace,
+			want:        nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := hqgologgerloggertest.DefaultRecorderConfig()
+
+			cfg.Level = test.sinkLevel
+
+			recorder := hqgologgerloggertest.NewRecorder(cfg)
+
+			logger := hqgologger.NewLogger()
+
+			logger.SetLevel(test.loggerLevel)
+			logger.AddSink(recorder.Sink())
+
+			logger.Error("error")
+			logger.Warn("warn")
+			logger.Info("info")
+			logger.Debug("debug")
+			logger.Trace("trace")
+
+			if got := recorder.All().Messages(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("sink recorded %q, want %q", got, test.want)
+			}
+
+			if got, want := logger.Enabled(hqgologgerlevels.LevelDebug), test.loggerLevel >= hqgologgerlevels.LevelDebug && test.sinkLevel >= hqgologgerlevels.LevelDebug; got != want {
+				t.Errorf("Enabled(LevelDebug) = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	hqgologgerformatter "github.com/hueristiq/hq-go-logger/formatter"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// SlogHandler is an implementation of the slog.Handler interface backed by a Logger. It
+// converts each slog.Record into a log event and passes it to Logger.Log, so libraries
+// that log with log/slog go through the same level filtering, formatter, and writer as
+// the rest of the application. slog levels are mapped to the levels package with
+// LevelFromSlog. Attributes become metadata; attributes inside groups, whether added
+// with WithGroup or as group-valued attributes, are flattened into dot-separated keys
+// (e.g., "request.method").
+//
+// Fields:
+//   - logger (*Logger): The Logger that records are passed to.
+//   - cfg (*SlogHandlerConfiguration): Configuration settings for the handler.
+//   - prefix (string): The dot-separated group path prepended to attribute keys,
+//     including a trailing dot if non-empty.
+//   - metadata (hqgologgerformatter.Fields): Metadata from attributes added with
+//     WithAttrs. The slice is never modified after the handler is created.
+type SlogHandler struct {
+	logger   *Logger
+	cfg      *SlogHandlerConfiguration
+	prefix   string
+	metadata hqgologgerformatter.Fields
+}
+
+// Enabled reports whether the handler's Logger would log a record at the given slog
+// level, allowing slog to skip building records that would be discarded.
+//
+// Parameters:
+//   - ctx (context.Context): Unused; present to satisfy the slog.Handler interface.
+//   - level (slog.Level): The slog level of the record.
+//
+// Returns:
+//   - enabled (bool): True if records at the level would be logged.
+func (h *SlogHandler) Enabled(_ context.Context, level slog.Level) (enabled bool) {
+	enabled = h.logger.Enabled(LevelFromSlog(level))
+
+	return
+}
+
+// Handle converts a slog.Record into a log event and passes it to the handler's Logger.
+// The record's level, message, and time become the event's level, message, and timestamp;
+// a zero time produces an event without a timestamp. Attributes added with WithAttrs are
+// applied first, followed by the record's own attributes, and finally the metadata carried
+// by ctx (see ContextWithFields) for keys not already set. If AddSource is enabled or the
+// Logger captures callers, the record's program counter is used as the event's caller.
+//
+// Parameters:
+//   - ctx (context.Context): The context carrying request-scoped metadata.
+//   - record (slog.Record): The record to log.
+//
+// Returns:
+//   - err (error): Always nil, as Logger.Log does not report errors.
+func (h *SlogHandler) Handle(ctx context.Context, record slog.Record) (err error) {
+	event := NewEvent(
+		WithLevel(LevelFromSlog(record.Level)),
+		WithMessage(record.Message),
+	)
+
+	event.SetTimestamp(record.Time)
+
+	event.metadata = append(event.metadata, h.metadata...)
+
+	record.Attrs(func(attr slog.Attr) bool {
+		h.addAttr(&event.metadata, h.prefix, attr)
+
+		return true
+	})
+
+	_AddContextMetadata(ctx, event)
+
+	if h.cfg.AddSource || h.logger.callerEnabled() {
+		event.pc = record.PC
+	}
+
+	h.logger.Log(event)
+
+	return
+}
+
+// WithAttrs returns a new handler whose records include the given attributes, qualified
+// by the handler's current group path. The receiver is not modified.
+//
+// Parameters:
+//   - attrs ([]slog.Attr): The attributes to add.
+//
+// Returns:
+//   - handler (slog.Handler): The new handler.
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) (handler slog.Handler) {
+	if len(attrs) == 0 {
+		handler = h
+
+		return
+	}
+
+	metadata := make(hqgologgerformatter.Fields, 0, len(h.metadata)+len(attrs))
+
+	metadata = append(metadata, h.metadata...)
+
+	for _, attr := range attrs {
+		h.addAttr(&metadata, h.prefix, attr)
+	}
+
+	handler = &SlogHandler{
+		logger:   h.logger,
+		cfg:      h.cfg,
+		prefix:   h.prefix,
+		metadata: metadata,
+	}
+
+	return
+}
+
+// WithGroup returns a new handler that qualifies the keys of all subsequently added
+// attributes with the given group name. An empty name returns the receiver unchanged.
+//
+// Parameters:
+//   - name (string): The group name.
+//
+// Returns:
+//   - handler (slog.Handler): The new handler.
+func (h *SlogHandler) WithGroup(name string) (handler slog.Handler) {
+	if name == "" {
+		handler = h
+
+		return
+	}
+
+	handler = &SlogHandler{
+		logger:   h.logger,
+		cfg:      h.cfg,
+		prefix:   h.prefix + name + ".",
+		metadata: h.metadata,
+	}
+
+	return
+}
+
+// addAttr resolves an attribute and stores it in metadata under its key qualified by
+// prefix. Empty attributes are ignored, group-valued attributes are flattened, and
+// groups with an empty key are inlined into the enclosing group.
+//
+// Parameters:
+//   - metadata (*hqgologgerformatter.Fields): The metadata to add the attribute to.
+//   - prefix (string): The group path to prepend to the attribute's key.
+//   - attr (slog.Attr): The attribute to add.
+func (h *SlogHandler) addAttr(metadata *hqgologgerformatter.Fields, prefix string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			prefix += attr.Key + "."
+		}
+
+		for _, a := range attr.Value.Group() {
+			h.addAttr(metadata, prefix, a)
+		}
+
+		return
+	}
+
+	metadata.Set(prefix+attr.Key, attr.Value.Any())
+}
+
+// SlogHandlerConfiguration defines configuration options for the SlogHandler.
+//
+// Fields:
+//   - AddSource (bool): If true, the source location of each record, taken from its
+//     program counter, is passed to formatters even if the Logger does not capture
+//     callers (see Logger.SetCaller).
+type SlogHandlerConfiguration struct {
+	AddSource bool
+}
+
+var _ slog.Handler = (*SlogHandler)(nil)
+
+// DefaultSlogHandlerConfig returns a default configuration for the SlogHandler, which
+// does not add source locations.
+//
+// Returns:
+//   - cfg (*SlogHandlerConfiguration): A pointer to the default configuration.
+func DefaultSlogHandlerConfig() (cfg *SlogHandlerConfiguration) {
+	cfg = &SlogHandlerConfiguration{
+		AddSource: false,
+	}
+
+	return
+}
+
+// NewSlogHandler creates and returns a new SlogHandler that logs records through the
+// given Logger. If no configuration is provided (i.e., cfg is nil), it uses the default
+// configuration from DefaultSlogHandlerConfig. The handler is typically passed to
+// slog.New or slog.SetDefault.
+//
+// Parameters:
+//   - logger (*Logger): The Logger that records are passed to.
+//   - cfg (*SlogHandlerConfiguration): The configuration for the handler. If nil,
+//     defaults are applied.
+//
+// Returns:
+//   - handler (*SlogHandler): A pointer to a new SlogHandler instance.
+func NewSlogHandler(logger *Logger, cfg *SlogHandlerConfiguration) (handler *SlogHandler) {
+	if cfg == nil {
+		cfg = DefaultSlogHandlerConfig()
+	}
+
+	handler = &SlogHandler{
+		logger: logger,
+		cfg:    cfg,
+	}
+
+	return
+}
+
+// LevelFromSlog maps a slog.Level to a level from the levels package. Levels below
+// slog.LevelDebug map to LevelTrace, levels below slog.LevelInfo to LevelDebug, levels
+// below slog.LevelWarn to LevelInfo, levels below slog.LevelError to LevelWarn, and all
+// higher levels to LevelError. No slog level maps to LevelFatal, so slog records never
+// terminate the program.
+//
+// Parameters:
+//   - level (slog.Level): The slog level to map.
+//
+// Returns:
+//   - mapped (hqgologgerlevels.Level): The corresponding level.
+func LevelFromSlog(level slog.Level) (mapped hqgologgerlevels.Level) {
+	switch {
+	case level < slog.LevelDebug:
+		mapped = hqgologgerlevels.LevelTrace
+	case level < slog.LevelInfo:
+		mapped = hqgologgerlevels.LevelDebug
+	case level < slog.LevelWarn:
+		mapped = hqgologgerlevels.LevelInfo
+	case level < slog.LevelError:
+		mapped = hqgologgerlevels.LevelWarn
+	default:
+		mapped = hqgologgerlevels.LevelError
+	}
+
+	return
+}
+
+// LevelToSlog maps a level from the levels package to a slog.Level. LevelFatal and
+// LevelError map to slog.LevelError, LevelWarn to slog.LevelWarn, LevelDebug to
+// slog.LevelDebug, LevelTrace to slog.LevelDebug-4, and LevelInfo, LevelSilent, and
+// unknown levels to slog.LevelInfo.
+//
+// Parameters:
+//   - level (hqgologgerlevels.Level): The level to map.
+//
+// Returns:
+//   - mapped (slog.Level): The corresponding slog level.
+func LevelToSlog(level hqgologgerlevels.Level) (mapped slog.Level) {
+	switch level {
+	case hqgologgerlevels.LevelFatal, hqgologgerlevels.LevelError:
+		mapped = slog.LevelError
+	case hqgologgerlevels.LevelWarn:
+		mapped = slog.LevelWarn
+	case hqgologgerlevels.LevelDebug:
+		mapped = slog.LevelDebug
+	case hqgologgerlevels.LevelTrace:
+		mapped = slog.LevelDebug - 4
+	default:
+		mapped = slog.LevelInfo
+	}
+
+	return
+}
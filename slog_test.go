@@ -0,0 +1,229 @@
+package logger_test
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+// slogResult converts a recorded entry back into the form expected by slogtest, with
+// dot-separated keys expanded into nested groups.
+func slogResult(entry hqgologgerloggertest.Entry) (result map[string]any) {
+	result = map[string]any{
+		slog.LevelKey:   hqgologger.LevelToSlog(entry.Level),
+		slog.MessageKey: entry.Message,
+	}
+
+	if !entry.Timestamp.IsZero() {
+		result[slog.TimeKey] = entry.Timestamp
+	}
+
+	for _, field := range entry.Metadata {
+		if field.Key == "label" {
+			continue
+		}
+
+		group := result
+
+		path := strings.Split(field.Key, ".")
+
+		for _, name := range path[:len(path)-1] {
+			nested, ok := group[name].(map[string]any)
+			if !ok {
+				nested = map[string]any{}
+
+				group[name] = nested
+			}
+
+			group = nested
+		}
+
+		group[path[len(path)-1]] = field.Any()
+	}
+
+	return
+}
+
+func TestSlogHandlerConformance(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	handler := hqgologger.NewSlogHandler(logger, nil)
+
+	err := slogtest.TestHandler(handler, func() (results []map[string]any) {
+		for _, entry := range recorder.All() {
+			results = append(results, slogResult(entry))
+		}
+
+		return
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+// slogToken is a slog.LogValuer resolving to a redacted group.
+type slogToken string
+
+func (token slogToken) LogValue() (value slog.Value) {
+	value = slog.GroupValue(slog.String("kind", "bearer"), slog.Int("length", len(token)))
+
+	return
+}
+
+func TestSlogHandlerAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		log  func(logger *slog.Logger)
+		want map[string]any
+	}{
+		{
+			name: "nested groups",
+			log: func(logger *slog.Logger) {
+				logger.With("service", "api").WithGroup("request").With("id", 7).WithGroup("client").
+					Info("message", "ip", "10.0.0.1", slog.Group("geo", "country", "NL"))
+			},
+			want: map[string]any{"service": "api", "request.id": int64(7), "request.client.ip": "10.0.0.1", "request.client.geo.country": "NL"},
+		},
+		{
+			name: "empty groups",
+			log: func(logger *slog.Logger) {
+				logger.WithGroup("").WithGroup("unused").Info("message", slog.Group("empty"), "k", "v")
+			},
+			want: map[string]any{"unused.k": "v"},
+		},
+		{
+			name: "inline group",
+			log: func(logger *slog.Logger) {
+				logger.Info("message", slog.Group("", "a", 1, "b", true))
+			},
+			want: map[string]any{"a": int64(1), "b": true},
+		},
+		{
+			name: "log valuer",
+			log: func(logger *slog.Logger) {
+				logger.Info("message", "token", slogToken("secret"))
+			},
+			want: map[string]any{"token.kind": "bearer", "token.length": int64(6)},
+		},
+		{
+			name: "record overrides bound attribute",
+			log: func(logger *slog.Logger) {
+				logger.With("k", "bound").Info("message", "k", "record")
+			},
+			want: map[string]any{"k": "record"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger, recorder := hqgologgerloggertest.New(t)
+
+			test.log(slog.New(hqgologger.NewSlogHandler(logger, nil)))
+
+			entries := recorder.All()
+
+			if len(entries) != 1 {
+				t.Fatalf("recorded %d entries, want 1", len(entries))
+			}
+
+			got := map[string]any{}
+
+			for _, field := range entries[0].Metadata {
+				if field.Key != "label" {
+					got[field.Key] = field.Any()
+				}
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("metadata = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSlogLevels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		slog   slog.Level
+		level  hqgologgerlevels.Level
+		mapped slog.Level
+	}{
+		{slog: slog.LevelDebug - 4, level: hqgologgerlevels.LevelTrace, mapped: slog.LevelDebug - 4},
+		{slog: slog.LevelDebug - 1, level: hqgologgerlevels.LevelTrace, mapped: slog.LevelDebug - 4},
+		{slog: slog.LevelDebug, level: hqgologgerlevels.LevelDebug, mapped: slog.LevelDebug},
+		{slog: slog.LevelInfo - 1, level: hqgologgerlevels.LevelDebug, mapped: slog.LevelDebug},
+		{slog: slog.LevelInfo, level: hqgologgerlevels.LevelInfo, mapped: slog.LevelInfo},
+		{slog: slog.LevelWarn, level: hqgologgerlevels.LevelWarn, mapped: slog.LevelWarn},
+		{slog: slog.LevelError, level: hqgologgerlevels.LevelError, mapped: slog.LevelError},
+		{slog: slog.LevelError + 8, level: hqgologgerlevels.LevelError, mapped: slog.LevelError},
+	}
+
+	for _, test := range tests {
+		if got := hqgologger.LevelFromSlog(test.slog); got != test.level {
+			t.Errorf("LevelFromSlog(%v) = %v, want %v", test.slog, got, test.level)
+		}
+
+		if got := hqgologger.LevelToSlog(test.level); got != test.mapped {
+			t.Errorf("LevelToSlog(%v) = %v, want %v", test.level, got, test.mapped)
+		}
+	}
+
+	if got := hqgologger.LevelToSlog(hqgologgerlevels.LevelFatal); got != slog.LevelError {
+		t.Errorf("LevelToSlog(LevelFatal) = %v, want %v", got, slog.LevelError)
+	}
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetLevel(hqgologgerlevels.LevelInfo)
+
+	handler := hqgologger.NewSlogHandler(logger, nil)
+
+	if handler.Enabled(context.Background(), slog.LevelDebug) {
+		t.Error("Enabled(LevelDebug) = true for a logger at LevelInfo, want false")
+	}
+
+	if !handler.Enabled(context.Background(), slog.LevelWarn) {
+		t.Error("Enabled(LevelWarn) = false for a logger at LevelInfo, want true")
+	}
+
+	slogger := slog.New(handler)
+
+	slogger.Debug("hidden")
+	slogger.Warn("shown")
+	slogger.Log(context.Background(), slog.LevelError+4, "critical")
+
+	if got, want := recorder.All().Messages(), []string{"shown", "critical"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded %q, want %q", got, want)
+	}
+
+	if got := recorder.FilterMessage("critical"); len(got) != 1 || got[0].Level != hqgologgerlevels.LevelError {
+		t.Errorf("a record above slog.LevelError was not logged at LevelError: %v", got)
+	}
+
+	var zero time.Time
+
+	record := slog.NewRecord(zero, slog.LevelInfo, "untimed", 0)
+
+	if err := handler.Handle(context.Background(), record); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	if got := recorder.FilterMessage("untimed"); len(got) != 1 || !got[0].Timestamp.IsZero() {
+		t.Errorf("a record without a time was logged with a timestamp: %v", got)
+	}
+}
@@ -0,0 +1,219 @@
+package logger
+
+import (
+	"io"
+	"log"
+	"strings"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// StdLogWriter is an implementation of io.Writer that logs everything written to it
+// through a Logger, allowing the standard library log package (and anything else that
+// writes lines to an io.Writer) to share the Logger's formatter and writer. Each call
+// to Write produces one event at the configured level. If level parsing is enabled, a
+// leading severity marker such as "[ERROR]", "WARN:" or "DEBUG" is removed from the
+// message and used as the event's level instead.
+//
+// Fields:
+//   - logger (*Logger): The Logger that written lines are passed to.
+//   - cfg (*StdLogWriterConfiguration): Configuration settings for the writer,
+//     controlling the default level and level parsing.
+type StdLogWriter struct {
+	logger *Logger
+	cfg    *StdLogWriterConfiguration
+}
+
+// Write logs p as a single event. Trailing newlines are removed, and if ParseLevel is
+// enabled, a recognized severity marker at the start of the message sets the event's
+// level. Markers for fatal or panic conditions map to LevelError rather than LevelFatal,
+// so that the writer never terminates the program on its own; log.Fatal and log.Panic
+// still exit or panic after the write returns. When the Logger captures callers, the
+// reported caller is the code that called the log.Logger print method (e.g., log.Printf).
+//
+// Parameters:
+//   - p ([]byte): The data to log, typically one line produced by a log.Logger.
+//
+// Returns:
+//   - n (int): Always len(p).
+//   - err (error): Always nil, as Logger.Log does not report errors.
+func (w *StdLogWriter) Write(p []byte) (n int, err error) {
+	n = len(p)
+
+	message := strings.TrimRight(string(p), "\r\n")
+
+	level := w.cfg.Level
+
+	if w.cfg.ParseLevel {
+		if parsed, rest, ok := parseStdLogLevel(message); ok {
+			level, message = parsed, rest
+		}
+	}
+
+	w.logger.emit(NewEvent(WithTimestamp(w.logger.now()), WithLevel(level), WithMessage(message)), 3)
+
+	return
+}
+
+// StdLogWriterConfiguration defines configuration options for the StdLogWriter.
+//
+// Fields:
+//   - Level (hqgologgerlevels.Level): The level of events produced from written lines
+//     that carry no recognized severity marker.
+//   - ParseLevel (bool): If true, a leading severity marker (e.g., "[ERROR]", "WARN:")
+//     is removed from the message and determines the event's level.
+type StdLogWriterConfiguration struct {
+	Level      hqgologgerlevels.Level
+	ParseLevel bool
+}
+
+var _ io.Writer = (*StdLogWriter)(nil)
+
+// DefaultStdLogWriterConfig returns a default configuration for the StdLogWriter, which
+// logs at LevelInfo and parses severity markers.
+//
+// Returns:
+//   - cfg (*StdLogWriterConfiguration): A pointer to the default configuration.
+func DefaultStdLogWriterConfig() (cfg *StdLogWriterConfiguration) {
+	cfg = &StdLogWriterConfiguration{
+		Level:      hqgologgerlevels.LevelInfo,
+		ParseLevel: true,
+	}
+
+	return
+}
+
+// NewStdLogWriter creates and returns a new StdLogWriter that logs through the given
+// Logger. If no configuration is provided (i.e., cfg is nil), it uses the default
+// configuration from DefaultStdLogWriterConfig.
+//
+// Parameters:
+//   - logger (*Logger): The Logger that written lines are passed to.
+//   - cfg (*StdLogWriterConfiguration): The configuration for the writer. If nil,
+//     defaults are applied.
+//
+// Returns:
+//   - writer (*StdLogWriter): A pointer to a new StdLogWriter instance.
+func NewStdLogWriter(logger *Logger, cfg *StdLogWriterConfiguration) (writer *StdLogWriter) {
+	if cfg == nil {
+		cfg = DefaultStdLogWriterConfig()
+	}
+
+	writer = &StdLogWriter{
+		logger: logger,
+		cfg:    cfg,
+	}
+
+	return
+}
+
+// NewStdLogger creates and returns a *log.Logger that writes through the given Logger
+// using a StdLogWriter. The returned logger has no flags set, since timestamps are added
+// by the Logger's formatter. It is useful for APIs that accept a *log.Logger, such as
+// http.Server.ErrorLog.
+//
+// Parameters:
+//   - logger (*Logger): The Logger that lines are passed to.
+//   - cfg (*StdLogWriterConfiguration): The configuration for the underlying writer. If
+//     nil, defaults are applied.
+//
+// Returns:
+//   - std (*log.Logger): A pointer to a new log.Logger instance.
+func NewStdLogger(logger *Logger, cfg *StdLogWriterConfiguration) (std *log.Logger) {
+	std = log.New(NewStdLogWriter(logger, cfg), "", 0)
+
+	return
+}
+
+// RedirectStdLog redirects the output of the standard library's default logger (the
+// log.Print, log.Printf, etc. functions) through the given Logger using a StdLogWriter.
+// The standard logger's flags are cleared so that its own timestamps and file
+// locations are not duplicated in the output; its prefix is kept. The returned
+// function restores the previous output and flags.
+//
+// Parameters:
+//   - logger (*Logger): The Logger that lines are passed to.
+//   - cfg (*StdLogWriterConfiguration): The configuration for the underlying writer. If
+//     nil, defaults are applied.
+//
+// Returns:
+//   - restore (func()): A function restoring the standard logger's previous configuration.
+func RedirectStdLog(logger *Logger, cfg *StdLogWriterConfiguration) (restore func()) {
+	output, flags := log.Writer(), log.Flags()
+
+	log.SetOutput(NewStdLogWriter(logger, cfg))
+	log.SetFlags(0)
+
+	restore = func() {
+		log.SetOutput(output)
+		log.SetFlags(flags)
+	}
+
+	return
+}
+
+// parseStdLogLevel recognizes a severity marker at the start of message. A marker is a
+// case-insensitive level name enclosed in square brackets (e.g., "[error]") or followed
+// by a colon (e.g., "Error:"), or an uppercase level name followed by whitespace (e.g.,
+// "ERROR "). Lowercase bare words are not markers, so messages such as "error reading
+// file" keep their text.
+//
+// Parameters:
+//   - message (string): The message to parse.
+//
+// Returns:
+//   - level (hqgologgerlevels.Level): The level indicated by the marker.
+//   - rest (string): The message with the marker and following whitespace removed.
+//   - ok (bool): True if a marker was recognized.
+func parseStdLogLevel(message string) (level hqgologgerlevels.Level, rest string, ok bool) {
+	trimmed := strings.TrimLeft(message, " \t")
+
+	var token string
+
+	switch {
+	case strings.HasPrefix(trimmed, "["):
+		end := strings.IndexByte(trimmed, ']')
+		if end < 0 {
+			return
+		}
+
+		token, rest = trimmed[1:end], trimmed[end+1:]
+	default:
+		end := strings.IndexAny(trimmed, " \t:")
+		if end < 0 {
+			end = len(trimmed)
+		}
+
+		token, rest = trimmed[:end], trimmed[end:]
+
+		switch {
+		case strings.HasPrefix(rest, ":"):
+			rest = rest[1:]
+		case token != strings.ToUpper(token):
+			return
+		}
+	}
+
+	if level, ok = stdLogLevels[strings.ToLower(token)]; !ok {
+		return
+	}
+
+	rest = strings.TrimLeft(rest, " \t")
+
+	return
+}
+
+// stdLogLevels maps the lowercase severity markers recognized by parseStdLogLevel to
+// their levels.
+var stdLogLevels = map[string]hqgologgerlevels.Level{
+	"panic":   hqgologgerlevels.LevelError,
+	"fatal":   hqgologgerlevels.LevelError,
+	"crit":    hqgologgerlevels.LevelError,
+	"error":   hqgologgerlevels.LevelError,
+	"err":     hqgologgerlevels.LevelError,
+	"warning": hqgologgerlevels.LevelWarn,
+	"warn":    hqgologgerlevels.LevelWarn,
+	"info":    hqgologgerlevels.LevelInfo,
+	"debug":   hqgologgerlevels.LevelDebug,
+	"trace":   hqgologgerlevels.LevelTrace,
+}
@@ -0,0 +1,112 @@
+package logger_test
+
+import (
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestStdLogLevelParsing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line    string
+		level   hqgologgerlevels.Level
+		message string
+	}{
+		{line: "[ERROR] disk full", level: hqgologgerlevels.LevelError, message: "disk full"},
+		{line: "[warn]retrying", level: hqgologgerlevels.LevelWarn, message: "retrying"},
+		{line: "[Debug]   padded", level: hqgologgerlevels.LevelDebug, message: "padded"},
+		{line: "Error: connection reset", level: hqgologgerlevels.LevelError, message: "connection reset"},
+		{line: "warning:low memory", level: hqgologgerlevels.LevelWarn, message: "low memory"},
+		{line: "WARN cache miss", level: hqgologgerlevels.LevelWarn, message: "cache miss"},
+		{line: "  TRACE\tindented", level: hqgologgerlevels.LevelTrace, message: "indented"},
+		{line: "INFO", level: hqgologgerlevels.LevelInfo, message: ""},
+		{line: "FATAL: giving up", level: hqgologgerlevels.LevelError, message: "giving up"},
+		{line: "[panic] recovered", level: hqgologgerlevels.LevelError, message: "recovered"},
+		{line: "CRIT overheating", level: hqgologgerlevels.LevelError, message: "overheating"},
+		{line: "error reading file", level: hqgologgerlevels.LevelWarn, message: "error reading file"},
+		{line: "Error reading file", level: hqgologgerlevels.LevelWarn, message: "Error reading file"},
+		{line: "[unknown] marker", level: hqgologgerlevels.LevelWarn, message: "[unknown] marker"},
+		{line: "[ERROR unterminated", level: hqgologgerlevels.LevelWarn, message: "[ERROR unterminated"},
+		{line: "NOTICE something", level: hqgologgerlevels.LevelWarn, message: "NOTICE something"},
+		{line: "plain message\r\n", level: hqgologgerlevels.LevelWarn, message: "plain message"},
+		{line: "", level: hqgologgerlevels.LevelWarn, message: ""},
+	}
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	writer := hqgologger.NewStdLogWriter(logger, &hqgologger.StdLogWriterConfiguration{
+		Level:      hqgologgerlevels.LevelWarn,
+		ParseLevel: true,
+	})
+
+	for _, test := range tests {
+		if _, err := writer.Write([]byte(test.line)); err != nil {
+			t.Fatalf("Write(%q) error = %v", test.line, err)
+		}
+
+		entries := recorder.TakeAll()
+
+		if len(entries) != 1 {
+			t.Fatalf("Write(%q) recorded %d entries, want 1", test.line, len(entries))
+		}
+
+		if entries[0].Level != test.level || entries[0].Message != test.message {
+			t.Errorf("Write(%q) logged %v %q, want %v %q", test.line, entries[0].Level, entries[0].Message, test.level, test.message)
+		}
+	}
+}
+
+func TestStdLogWithoutLevelParsing(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	std := hqgologger.NewStdLogger(logger, &hqgologger.StdLogWriterConfiguration{
+		Level:      hqgologgerlevels.LevelDebug,
+		ParseLevel: false,
+	})
+
+	std.Printf("[ERROR] kept as %s", "text")
+
+	entries := recorder.All()
+
+	if len(entries) != 1 {
+		t.Fatalf("recorded %d entries, want 1", len(entries))
+	}
+
+	if entries[0].Level != hqgologgerlevels.LevelDebug || entries[0].Message != "[ERROR] kept as text" {
+		t.Errorf("logged %v %q, want %v %q", entries[0].Level, entries[0].Message, hqgologgerlevels.LevelDebug, "[ERROR] kept as text")
+	}
+}
+
+func TestStdLoggerDefaults(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	logger.SetLevel(hqgologgerlevels.LevelInfo)
+
+	std := hqgologger.NewStdLogger(logger, nil)
+
+	std.Print("DEBUG filtered")
+	std.Print("served")
+	std.Print("[error] failed")
+
+	entries := recorder.All()
+
+	if len(entries) != 2 {
+		t.Fatalf("recorded %q, want 2 entries", entries.Messages())
+	}
+
+	if entries[0].Level != hqgologgerlevels.LevelInfo || entries[0].Message != "served" {
+		t.Errorf("logged %v %q, want %v \"served\"", entries[0].Level, entries[0].Message, hqgologgerlevels.LevelInfo)
+	}
+
+	if entries[1].Level != hqgologgerlevels.LevelError || entries[1].Message != "failed" {
+		t.Errorf("logged %v %q, want %v \"failed\"", entries[1].Level, entries[1].Message, hqgologgerlevels.LevelError)
+	}
+}
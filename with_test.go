@@ -0,0 +1,126 @@
+package logger_test
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	hqgologger "github.com/hueristiq/hq-go-logger"
+	hqgologgerloggertest "github.com/hueristiq/hq-go-logger/loggertest"
+)
+
+func TestWithFieldPrecedence(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	parent := logger.With(hqgologger.WithString("a", "parent"), hqgologger.WithString("b", "parent"))
+	child := parent.With(hqgologger.WithString("b", "child"), hqgologger.WithString("c", "child"))
+
+	child.Info("child", hqgologger.WithString("c", "event"), hqgologger.WithString("d", "event"))
+	parent.Info("parent")
+	logger.Info("root")
+
+	tests := []struct {
+		message string
+		want    map[string]any
+		keys    []string
+	}{
+		{
+			message: "child",
+			want:    map[string]any{"a": "parent", "b": "child", "c": "event", "d": "event"},
+			keys:    []string{"a", "b", "c", "d"},
+		},
+		{
+			message: "parent",
+			want:    map[string]any{"a": "parent", "b": "parent"},
+			keys:    []string{"a", "b"},
+		},
+		{
+			message: "root",
+			want:    map[string]any{},
+			keys:    []string{},
+		},
+	}
+
+	for _, test := range tests {
+		entries := recorder.FilterMessage(test.message)
+
+		if len(entries) != 1 {
+			t.Fatalf("%s: recorded %d entries, want 1", test.message, len(entries))
+		}
+
+		got := map[string]any{}
+		keys := []string{}
+
+		for _, field := range entries[0].Metadata {
+			if field.Key == "label" {
+				continue
+			}
+
+			got[field.Key] = field.Any()
+			keys = append(keys, field.Key)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: metadata = %v, want %v", test.message, got, test.want)
+		}
+
+		if !reflect.DeepEqual(keys, test.keys) {
+			t.Errorf("%s: keys = %q, want %q", test.message, keys, test.keys)
+		}
+	}
+}
+
+func TestWithConcurrent(t *testing.T) {
+	t.Parallel()
+
+	logger, recorder := hqgologgerloggertest.New(t)
+
+	parent := logger.With(hqgologger.WithString("service", "api"))
+
+	const (
+		goroutines = 8
+		events     = 50
+	)
+
+	var wg sync.WaitGroup
+
+	for i := range goroutines {
+		wg.Go(func() {
+			worker := strconv.Itoa(i)
+
+			for j := range events {
+				child := parent.With(hqgologger.WithString("worker", worker), hqgologger.WithInt("event", j))
+
+				child.Info("child", hqgologger.WithString("extra", worker))
+				parent.Info("parent")
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if got := len(recorder.FilterMessage("parent").FilterField("service", "api")); got != goroutines*events {
+		t.Errorf("recorded %d parent events, want %d", got, goroutines*events)
+	}
+
+	if got := len(recorder.FilterMessage("parent").FilterFieldKey("worker")); got != 0 {
+		t.Errorf("%d parent events carry a child's fields, want 0", got)
+	}
+
+	for i := range goroutines {
+		worker := strconv.Itoa(i)
+
+		entries := recorder.FilterMessage("child").FilterField("worker", worker)
+
+		if len(entries) != events {
+			t.Errorf("worker %s: recorded %d child events, want %d", worker, len(entries), events)
+		}
+
+		if got := len(entries.FilterField("extra", worker).FilterField("service", "api")); got != events {
+			t.Errorf("worker %s: %d child events carry their own and bound fields, want %d", worker, got, events)
+		}
+	}
+}
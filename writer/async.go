@@ -0,0 +1,369 @@
+package writer
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// Async is a thread-safe implementation of the Writer interface that decouples logging
+// from slow output destinations. Write copies the formatted message onto a bounded queue
+// and returns immediately, while a background goroutine forwards queued messages to the
+// wrapped Writer in order. When the queue is full, the configured OverflowPolicy decides
+// whether Write blocks or a message is dropped; dropped messages are counted and can be
+// retrieved with Dropped. Flush waits until the queue is drained, and Close waits at
+// most CloseTimeout for the queue to be drained; the wrapped Writer is closed by the
+// background goroutine once it has written every queued message, so it is never closed
+// while a message is being written to it.
+//
+// Fields:
+//   - mutex (*sync.RWMutex): Held for reading while enqueuing and for writing while
+//     closing the queue, ensuring no message is enqueued after the queue is closed.
+//   - writer (Writer): The wrapped Writer that queued messages are forwarded to.
+//   - cfg (*AsyncWriterConfiguration): Configuration settings controlling the queue size,
+//     overflow policy, and close timeout.
+//   - queue (chan _AsyncMessage): The bounded queue of pending messages.
+//   - done (chan struct{}): Closed by the background goroutine once the queue is drained
+//     and the wrapped Writer closed.
+//   - closing (chan struct{}): Closed by Close, releasing Write calls blocked on a full
+//     queue.
+//   - once (*sync.Once): Ensures closing is closed only once.
+//   - closeErr (error): The error returned by closing the wrapped Writer, set before
+//     done is closed.
+//   - dropped (*atomic.Uint64): The number of messages dropped due to a full queue.
+//   - pending (int): The number of enqueued messages not yet written, guarded by idle's lock.
+//   - idle (*sync.Cond): Signaled when pending drops to zero, awaited by Flush.
+type Async struct {
+	mutex    *sync.RWMutex
+	writer   Writer
+	cfg      *AsyncWriterConfiguration
+	queue    chan _AsyncMessage
+	done     chan struct{}
+	closing  chan struct{}
+	once     *sync.Once
+	closeErr error
+	dropped  *atomic.Uint64
+	pending  int
+	idle     *sync.Cond
+}
+
+// _AsyncMessage is a message waiting in the queue of an Async writer.
+//
+// Fields:
+//   - data ([]byte): A copy of the formatted log message.
+//   - level (hqgologgerlevels.Level): The severity level of the log message.
+type _AsyncMessage struct {
+	data  []byte
+	level hqgologgerlevels.Level
+}
+
+// Write enqueues a copy of the provided log data for the background goroutine. If the
+// queue is full, the OverflowPolicy applies: OverflowBlock waits for space,
+// OverflowDropNewest discards this message, OverflowDropOldest discards the oldest
+// queued message to make room, and OverflowDropBelowLevel discards this message if it
+// is less severe than DropLevel and waits for space otherwise. A Write waiting for
+// space returns ErrWriterClosed if the writer is closed meanwhile. The method is
+// thread-safe.
+//
+// Parameters:
+//   - data ([]byte): The pre-formatted log message to write. It is copied, so the
+//     caller may reuse the slice.
+//   - level (hqgologgerlevels.Level): The severity level of the log message, forwarded
+//     to the wrapped Writer.
+//
+// Returns:
+//   - err (error): ErrWriterClosed if the writer has been closed, otherwise nil. Errors
+//     from the wrapped Writer are not reported, as writes happen asynchronously.
+func (a *Async) Write(data []byte, level hqgologgerlevels.Level) (err error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	select {
+	case <-a.closing:
+		err = ErrWriterClosed
+
+		return
+	default:
+	}
+
+	message := _AsyncMessage{
+		data:  append([]byte(nil), data...),
+		level: level,
+	}
+
+	a.addPending(1)
+
+	select {
+	case a.queue <- message:
+		return
+	default:
+	}
+
+	switch a.cfg.Policy {
+	case OverflowDropNewest:
+		a.drop()
+	case OverflowDropOldest:
+		for {
+			select {
+			case a.queue <- message:
+				return
+			default:
+			}
+
+			select {
+			case <-a.queue:
+				a.drop()
+			default:
+			}
+		}
+	case OverflowDropBelowLevel:
+		if level > a.cfg.DropLevel {
+			a.drop()
+
+			return
+		}
+
+		err = a.enqueue(message)
+	default:
+		err = a.enqueue(message)
+	}
+
+	return
+}
+
+// Flush blocks until every message accepted by Write so far has been written to the
+// wrapped Writer (or dropped), and then flushes the wrapped Writer if it implements the
+// Flusher interface.
+//
+// Returns:
+//   - err (error): An error if flushing the wrapped Writer fails, otherwise nil.
+func (a *Async) Flush() (err error) {
+	a.idle.L.Lock()
+
+	for a.pending > 0 {
+		a.idle.Wait()
+	}
+
+	a.idle.L.Unlock()
+
+	if flusher, ok := a.writer.(Flusher); ok {
+		err = flusher.Flush()
+	}
+
+	return
+}
+
+// Dropped returns the number of messages dropped so far because the queue was full.
+//
+// Returns:
+//   - count (uint64): The number of dropped messages.
+func (a *Async) Dropped() (count uint64) {
+	count = a.dropped.Load()
+
+	return
+}
+
+// Close stops accepting messages and waits for the background goroutine to write all
+// queued messages and close the wrapped Writer, at most CloseTimeout if positive. If
+// the timeout elapses, Close returns while the background goroutine keeps writing the
+// remaining messages, and closes the wrapped Writer once done. Closing an already
+// closed writer is a no-op.
+//
+// Returns:
+//   - err (error): ErrCloseTimeout if the queue was not drained in time, otherwise the
+//     error returned by closing the wrapped Writer.
+func (a *Async) Close() (err error) {
+	first := false
+
+	a.once.Do(func() {
+		first = true
+
+		close(a.closing)
+	})
+
+	if !first {
+		return
+	}
+
+	// Write calls blocked on a full queue are released by closing, so this does not
+	// wait for the queue to have space.
+	a.mutex.Lock()
+
+	close(a.queue)
+
+	a.mutex.Unlock()
+
+	if a.cfg.CloseTimeout > 0 {
+		timer := time.NewTimer(a.cfg.CloseTimeout)
+
+		defer timer.Stop()
+
+		select {
+		case <-a.done:
+		case <-timer.C:
+			err = fmt.Errorf("%w: %d messages not written", ErrCloseTimeout, len(a.queue))
+
+			return
+		}
+	} else {
+		<-a.done
+	}
+
+	err = a.closeErr
+
+	return
+}
+
+// run forwards queued messages to the wrapped Writer until the queue is closed and
+// drained, then closes the wrapped Writer and done.
+func (a *Async) run() {
+	defer close(a.done)
+
+	for message := range a.queue {
+		_ = a.writer.Write(message.data, message.level)
+
+		a.addPending(-1)
+	}
+
+	a.closeErr = a.writer.Close()
+}
+
+// enqueue waits until there is space in the queue for the message, or until the writer
+// is closed.
+//
+// Parameters:
+//   - message (_AsyncMessage): The message to enqueue.
+//
+// Returns:
+//   - err (error): ErrWriterClosed if the writer was closed before the message could be
+//     enqueued, otherwise nil.
+func (a *Async) enqueue(message _AsyncMessage) (err error) {
+	select {
+	case a.queue <- message:
+	case <-a.closing:
+		a.addPending(-1)
+
+		err = ErrWriterClosed
+	}
+
+	return
+}
+
+// drop records a message discarded due to a full queue.
+func (a *Async) drop() {
+	a.dropped.Add(1)
+
+	a.addPending(-1)
+}
+
+// addPending adjusts the number of messages not yet written and wakes up Flush callers
+// once it reaches zero.
+//
+// Parameters:
+//   - delta (int): The amount to add to the pending count.
+func (a *Async) addPending(delta int) {
+	a.idle.L.Lock()
+	defer a.idle.L.Unlock()
+
+	a.pending += delta
+
+	if a.pending == 0 {
+		a.idle.Broadcast()
+	}
+}
+
+// OverflowPolicy determines what an Async writer does when its queue is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock makes Write wait until the queue has space. No message is lost, but
+	// logging slows down to the speed of the wrapped Writer.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest discards the message being written.
+	OverflowDropNewest
+	// OverflowDropOldest discards the oldest queued message to make room for the message
+	// being written.
+	OverflowDropOldest
+	// OverflowDropBelowLevel discards the message being written if it is less severe than
+	// AsyncWriterConfiguration.DropLevel, and blocks otherwise.
+	OverflowDropBelowLevel
+)
+
+// AsyncWriterConfiguration defines configuration options for the Async writer.
+//
+// Fields:
+//   - QueueSize (int): The maximum number of messages waiting to be written. Values
+//     below 1 are treated as 1.
+//   - Policy (OverflowPolicy): What Write does when the queue is full.
+//   - DropLevel (hqgologgerlevels.Level): With OverflowDropBelowLevel, messages less
+//     severe than this level are dropped when the queue is full.
+//   - CloseTimeout (time.Duration): The maximum time Close waits for the queue to be
+//     drained. Zero or negative waits indefinitely.
+type AsyncWriterConfiguration struct {
+	QueueSize    int
+	Policy       OverflowPolicy
+	DropLevel    hqgologgerlevels.Level
+	CloseTimeout time.Duration
+}
+
+var (
+	_ Writer  = (*Async)(nil)
+	_ Flusher = (*Async)(nil)
+)
+
+// DefaultAsyncWriterConfig returns a default configuration for the Async writer. The
+// default settings use a queue of 1024 messages, block when it is full, and wait up to
+// five seconds for it to drain on Close. With OverflowDropBelowLevel, LevelDebug and
+// LevelTrace messages are dropped by default.
+//
+// Returns:
+//   - cfg (*AsyncWriterConfiguration): A pointer to the default configuration.
+func DefaultAsyncWriterConfig() (cfg *AsyncWriterConfiguration) {
+	cfg = &AsyncWriterConfiguration{
+		QueueSize:    1024,
+		Policy:       OverflowBlock,
+		DropLevel:    hqgologgerlevels.LevelInfo,
+		CloseTimeout: 5 * time.Second,
+	}
+
+	return
+}
+
+// NewAsyncWriter creates and returns a new Async writer that wraps the given Writer and
+// starts its background goroutine. If no configuration is provided (i.e., cfg is nil),
+// it uses the default configuration from DefaultAsyncWriterConfig. The Async writer
+// takes ownership of the wrapped Writer and closes it on Close.
+//
+// Parameters:
+//   - writer (Writer): The Writer to forward messages to.
+//   - cfg (*AsyncWriterConfiguration): The configuration for the writer. If nil,
+//     defaults are applied.
+//
+// Returns:
+//   - async (*Async): A pointer to a new Async writer instance.
+func NewAsyncWriter(writer Writer, cfg *AsyncWriterConfiguration) (async *Async) {
+	if cfg == nil {
+		cfg = DefaultAsyncWriterConfig()
+	}
+
+	size := max(cfg.QueueSize, 1)
+
+	async = &Async{
+		mutex:   &sync.RWMutex{},
+		writer:  writer,
+		cfg:     cfg,
+		queue:   make(chan _AsyncMessage, size),
+		done:    make(chan struct{}),
+		closing: make(chan struct{}),
+		once:    &sync.Once{},
+		dropped: &atomic.Uint64{},
+		idle:    sync.NewCond(&sync.Mutex{}),
+	}
+
+	go async.run()
+
+	return
+}
@@ -0,0 +1,155 @@
+package writer_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+// blockingWriter is a Writer whose writes block until release is closed, and which
+// fails writes made after Close.
+type blockingWriter struct {
+	release chan struct{}
+	writes  atomic.Int64
+	closed  atomic.Bool
+	late    atomic.Bool
+}
+
+func (w *blockingWriter) Write(_ []byte, _ hqgologgerlevels.Level) (err error) {
+	<-w.release
+
+	if w.closed.Load() {
+		w.late.Store(true)
+	}
+
+	w.writes.Add(1)
+
+	return
+}
+
+func (w *blockingWriter) Close() (err error) {
+	w.closed.Store(true)
+
+	return
+}
+
+func TestAsyncCloseTimeoutLeavesClosingToWriter(t *testing.T) {
+	t.Parallel()
+
+	wrapped := &blockingWriter{release: make(chan struct{})}
+
+	cfg := hqgologgerwriter.DefaultAsyncWriterConfig()
+
+	cfg.QueueSize = 4
+	cfg.CloseTimeout = 10 * time.Millisecond
+
+	async := hqgologgerwriter.NewAsyncWriter(wrapped, cfg)
+
+	for range 3 {
+		if err := async.Write([]byte("message"), hqgologgerlevels.LevelInfo); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	if err := async.Close(); !errors.Is(err, hqgologgerwriter.ErrCloseTimeout) {
+		t.Fatalf("Close() error = %v, want %v", err, hqgologgerwriter.ErrCloseTimeout)
+	}
+
+	if wrapped.closed.Load() {
+		t.Fatal("wrapped writer closed while messages were still being written")
+	}
+
+	close(wrapped.release)
+
+	if err := async.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	for deadline := time.Now().Add(time.Second); !wrapped.closed.Load(); {
+		if time.Now().After(deadline) {
+			t.Fatal("wrapped writer not closed after the queue was drained")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	if got := wrapped.writes.Load(); got != 3 {
+		t.Errorf("wrapped writer got %d writes, want 3", got)
+	}
+
+	if wrapped.late.Load() {
+		t.Error("wrapped writer written to after being closed")
+	}
+}
+
+func TestAsyncCloseReleasesBlockedWrite(t *testing.T) {
+	t.Parallel()
+
+	wrapped := &blockingWriter{release: make(chan struct{})}
+
+	cfg := hqgologgerwriter.DefaultAsyncWriterConfig()
+
+	cfg.QueueSize = 1
+	cfg.Policy = hqgologgerwriter.OverflowBlock
+	cfg.CloseTimeout = 10 * time.Millisecond
+
+	async := hqgologgerwriter.NewAsyncWriter(wrapped, cfg)
+
+	// The first message is taken by the background goroutine, which blocks writing it,
+	// and the second fills the queue, so the third blocks on the full queue.
+	for range 2 {
+		if err := async.Write([]byte("message"), hqgologgerlevels.LevelInfo); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+
+	errs := make(chan error, 1)
+
+	var started sync.WaitGroup
+
+	started.Add(1)
+
+	go func() {
+		started.Done()
+
+		errs <- async.Write([]byte("message"), hqgologgerlevels.LevelInfo)
+	}()
+
+	started.Wait()
+
+	time.Sleep(10 * time.Millisecond)
+
+	closed := make(chan error, 1)
+
+	go func() {
+		closed <- async.Close()
+	}()
+
+	select {
+	case err := <-closed:
+		if !errors.Is(err, hqgologgerwriter.ErrCloseTimeout) {
+			t.Errorf("Close() error = %v, want %v", err, hqgologgerwriter.ErrCloseTimeout)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Close() blocked behind a Write waiting on a full queue")
+	}
+
+	if err := <-errs; err != nil && !errors.Is(err, hqgologgerwriter.ErrWriterClosed) {
+		t.Errorf("blocked Write() error = %v, want nil or %v", err, hqgologgerwriter.ErrWriterClosed)
+	}
+
+	close(wrapped.release)
+
+	if err := async.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if err := async.Write([]byte("message"), hqgologgerlevels.LevelInfo); !errors.Is(err, hqgologgerwriter.ErrWriterClosed) {
+		t.Errorf("Write() after Close error = %v, want %v", err, hqgologgerwriter.ErrWriterClosed)
+	}
+}
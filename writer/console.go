@@ -1,6 +1,7 @@
 package writer
 
 import (
+	"errors"
 	"io"
 	"os"
 	"sync"
@@ -34,8 +35,8 @@ type Console struct {
 // Write writes the provided log data to either stdout or stderr based on the
 // specified log level and configuration settings, appending a newline character
 // unless disabled. By default, messages with LevelSilent are written to stdout,
-// while all other levels (LevelFatal, LevelError, LevelInfo, LevelWarn, LevelDebug)
-// are written to stderr. Configuration options (ForceStderr or ForceStdout) can
+// while all other levels (LevelFatal, LevelError, LevelWarn, LevelInfo, LevelDebug,
+// LevelTrace) are written to stderr. Configuration options (ForceStderr or ForceStdout) can
 // override this behavior to direct all messages to a single stream. The method is
 // thread-safe, using a mutex to serialize write operations. If the output stream
 // supports flushing (e.g., via a Flush method), it is called to ensure immediate
@@ -74,7 +75,7 @@ func (c *Console) Write(data []byte, level hqgologgerlevels.Level) (err error) {
 	}
 
 	if !c.cfg.DisableNewline {
-		if _, err = writer.Write([]byte("\n")); err != nil {
+		if _, err = writer.Write(newline); err != nil {
 			return
 		}
 	}
@@ -90,33 +91,59 @@ func (c *Console) Write(data []byte, level hqgologgerlevels.Level) (err error) {
 	return
 }
 
-// Close closes the stdout and stderr streams if they are not os.Stdout or os.Stderr
-// and implement the io.Closer interface. This ensures proper resource cleanup for
-// custom output streams (e.g., file handles or network connections used in testing).
-// The method is thread-safe, using a mutex to prevent concurrent access. It attempts
-// to close both streams and returns the last non-nil error encountered, if any.
-// If the streams are os.Stdout or os.Stderr, they are not closed, as these are
-// managed by the operating system.
+// Flush flushes the stdout and stderr streams if they implement a Flush method (e.g., a
+// bufio.Writer). Writes to os.Stdout and os.Stderr are unbuffered, so for them this is a
+// no-op. The method is thread-safe.
 //
 // Returns:
-//   - err (error): The last non-nil error from closing either stream, or nil if
-//     both streams are closed successfully or are not closable (e.g., os.Stdout).
+//   - err (error): The last non-nil error from flushing either stream, or nil.
+func (c *Console) Flush() (err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, stream := range []io.Writer{c.stdout, c.stderr} {
+		if flusher, ok := stream.(interface{ Flush() error }); ok {
+			if flushErr := flusher.Flush(); flushErr != nil {
+				err = flushErr
+			}
+		}
+	}
+
+	return
+}
+
+// Close flushes the stdout and stderr streams (see Flush), then closes them if they are
+// not os.Stdout or os.Stderr and implement the io.Closer interface. This ensures proper
+// resource cleanup for custom output streams (e.g., file handles or network connections
+// used in testing). A stream used as both stdout and stderr is closed only once. If the
+// streams are os.Stdout or os.Stderr, they are not closed, as these are managed by the
+// operating system. The method is thread-safe, using a mutex to prevent concurrent
+// access.
+//
+// Returns:
+//   - err (error): The errors from flushing and closing the streams, joined with
+//     errors.Join, or nil if all succeeded or the streams are not closable (e.g.,
+//     os.Stdout).
 func (c *Console) Close() (err error) {
+	errs := []error{c.Flush()}
+
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
 	if c.stdout != os.Stdout {
 		if closer, ok := c.stdout.(io.Closer); ok {
-			err = closer.Close()
+			errs = append(errs, closer.Close())
 		}
 	}
 
-	if c.stderr != os.Stderr {
+	if c.stderr != os.Stderr && c.stderr != c.stdout {
 		if closer, ok := c.stderr.(io.Closer); ok {
-			err = closer.Close()
+			errs = append(errs, closer.Close())
 		}
 	}
 
+	err = errors.Join(errs...)
+
 	return
 }
 
@@ -132,17 +159,30 @@ func (c *Console) Close() (err error) {
 //   - DisableNewline (bool): If true, prevents appending a newline character to
 //     each log message, useful for custom formatting or when newlines are handled
 //     by the formatter.
+//   - Stdout (io.Writer): The stream used as standard output. If nil, os.Stdout is
+//     used. Setting it (e.g., to a bytes.Buffer) captures the output in tests.
+//   - Stderr (io.Writer): The stream used as standard error. If nil, os.Stderr is
+//     used.
 type ConsoleWriterConfiguration struct {
 	ForceStderr    bool
 	ForceStdout    bool
 	DisableNewline bool
+	Stdout         io.Writer
+	Stderr         io.Writer
 }
 
-var _ Writer = (*Console)(nil)
+// newline is written after each message unless DisableNewline is set. It is shared so
+// that writing it does not allocate.
+var newline = []byte{'\n'}
+
+var (
+	_ Writer  = (*Console)(nil)
+	_ Flusher = (*Console)(nil)
+)
 
 // DefaultConsoleWriterConfig returns a default configuration for the Console writer.
-// The default settings direct LevelSilent messages to stdout, other levels to stderr,
-// and append a newline to each message. This provides a sensible starting point for
+// The default settings direct LevelSilent messages to os.Stdout, other levels to
+// os.Stderr, and append a newline to each message. This provides a sensible starting point for
 // console logging that can be customized as needed.
 //
 // Returns:
@@ -152,6 +192,8 @@ func DefaultConsoleWriterConfig() (cfg *ConsoleWriterConfiguration) {
 		ForceStderr:    false,
 		ForceStdout:    false,
 		DisableNewline: false,
+		Stdout:         os.Stdout,
+		Stderr:         os.Stderr,
 	}
 
 	return
@@ -160,9 +202,9 @@ func DefaultConsoleWriterConfig() (cfg *ConsoleWriterConfiguration) {
 // NewConsoleWriter creates and returns a new Console writer instance, initialized
 // with a mutex for thread-safe operation and the provided configuration. If no
 // configuration is provided (i.e., cfg is nil), it uses the default configuration
-// from DefaultConsoleWriterConfig. The writer uses os.Stdout and os.Stderr as
-// default output streams but allows customization for testing or alternative
-// destinations. The instance is ready for use in a logging system to write
+// from DefaultConsoleWriterConfig. The writer uses the streams set in the
+// configuration, or os.Stdout and os.Stderr if they are nil, which allows writing to
+// arbitrary io.Writers for testing or alternative destinations. The instance is ready for use in a logging system to write
 // formatted log messages to console outputs.
 //
 // Parameters:
@@ -178,10 +220,18 @@ func NewConsoleWriter(cfg *ConsoleWriterConfiguration) (writer *Console) {
 
 	writer = &Console{
 		mutex:  &sync.Mutex{},
-		stdout: os.Stdout,
-		stderr: os.Stderr,
+		stdout: cfg.Stdout,
+		stderr: cfg.Stderr,
 		cfg:    cfg,
 	}
 
+	if writer.stdout == nil {
+		writer.stdout = os.Stdout
+	}
+
+	if writer.stderr == nil {
+		writer.stderr = os.Stderr
+	}
+
 	return
 }
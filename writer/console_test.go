@@ -0,0 +1,111 @@
+package writer_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+// closingBuffer is a bytes.Buffer that implements Flush and Close, counting the calls
+// and returning the configured errors.
+type closingBuffer struct {
+	bytes.Buffer
+
+	flushErr error
+	closeErr error
+	closes   int
+}
+
+func (b *closingBuffer) Flush() (err error) {
+	err = b.flushErr
+
+	return
+}
+
+func (b *closingBuffer) Close() (err error) {
+	b.closes++
+
+	err = b.closeErr
+
+	return
+}
+
+func TestConsoleWrite(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	cfg := hqgologgerwriter.DefaultConsoleWriterConfig()
+
+	cfg.Stdout = &stdout
+	cfg.Stderr = &stderr
+
+	console := hqgologgerwriter.NewConsoleWriter(cfg)
+
+	if err := console.Write([]byte("plain"), hqgologgerlevels.LevelSilent); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if err := console.Write([]byte("failed"), hqgologgerlevels.LevelError); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if got := stdout.String(); got != "plain\n" {
+		t.Errorf("stdout = %q, want %q", got, "plain\n")
+	}
+
+	if got := stderr.String(); got != "failed\n" {
+		t.Errorf("stderr = %q, want %q", got, "failed\n")
+	}
+}
+
+func TestConsoleClose(t *testing.T) {
+	t.Parallel()
+
+	flushErr := errors.New("flush failed")
+	closeErr := errors.New("close failed")
+
+	t.Run("shared stream closed once", func(t *testing.T) {
+		t.Parallel()
+
+		stream := &closingBuffer{}
+
+		cfg := hqgologgerwriter.DefaultConsoleWriterConfig()
+
+		cfg.Stdout = stream
+		cfg.Stderr = stream
+
+		if err := hqgologgerwriter.NewConsoleWriter(cfg).Close(); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+
+		if stream.closes != 1 {
+			t.Errorf("stream closed %d times, want 1", stream.closes)
+		}
+	})
+
+	t.Run("errors joined", func(t *testing.T) {
+		t.Parallel()
+
+		stdout := &closingBuffer{flushErr: flushErr}
+		stderr := &closingBuffer{closeErr: closeErr}
+
+		cfg := hqgologgerwriter.DefaultConsoleWriterConfig()
+
+		cfg.Stdout = stdout
+		cfg.Stderr = stderr
+
+		err := hqgologgerwriter.NewConsoleWriter(cfg).Close()
+
+		if !errors.Is(err, flushErr) || !errors.Is(err, closeErr) {
+			t.Errorf("Close() error = %v, want both %v and %v", err, flushErr, closeErr)
+		}
+
+		if stdout.closes != 1 || stderr.closes != 1 {
+			t.Errorf("streams closed %d and %d times, want 1 each", stdout.closes, stderr.closes)
+		}
+	})
+}
@@ -0,0 +1,636 @@
+package writer
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+)
+
+// File is a thread-safe implementation of the Writer interface that appends log
+// messages to a file and rotates it when it reaches a maximum size and/or when a time
+// interval (e.g., hourly or daily) elapses. On rotation, the current file is renamed
+// to a backup whose name carries the rotation time (e.g., "app-2025-08-08T13-45-00.000.log",
+// followed by a counter such as "-1" if a backup of the same time already exists) and a
+// new file is opened at the configured path. The current file is only closed once the
+// new one is open, so a failed rotation or reopen never leaves the writer without a
+// file to write to. Backups can be gzip-compressed and
+// are pruned by count and age in a background goroutine, so rotation never blocks
+// logging on compression. Each message is written with a single write call, so lines
+// are never split across files. The file can also be reopened on demand (e.g., on
+// SIGHUP) to cooperate with external tools that move it away.
+//
+// Fields:
+//   - mutex (*sync.Mutex): Ensures thread-safe access to the file and rotation state.
+//   - cfg (*FileWriterConfiguration): Configuration settings controlling the path,
+//     rotation triggers, retention, and compression.
+//   - file (*os.File): The currently open log file.
+//   - size (int64): The current size of the open file in bytes.
+//   - next (time.Time): The time of the next interval-based rotation, or zero if
+//     interval rotation is disabled.
+//   - closed (bool): Whether Close has been called.
+//   - buffer ([]byte): A buffer reused to append the newline to each message.
+//   - mill (*sync.Mutex): Serializes background compression and pruning of backups.
+//   - wg (*sync.WaitGroup): Tracks background compression and pruning, awaited by Close.
+type File struct {
+	mutex  *sync.Mutex
+	cfg    *FileWriterConfiguration
+	file   *os.File
+	size   int64
+	next   time.Time
+	closed bool
+	buffer []byte
+	mill   *sync.Mutex
+	wg     *sync.WaitGroup
+}
+
+// Write appends the provided log data to the file, followed by a newline unless
+// disabled, rotating the file first if the write would exceed MaxSize or the rotation
+// interval has elapsed. A single message larger than MaxSize is written to a fresh
+// file rather than being split. The level is not used for filtering; all messages
+// are written. The method is thread-safe.
+//
+// Parameters:
+//   - data ([]byte): The pre-formatted log message to write.
+//   - level (hqgologgerlevels.Level): The severity level of the log message. Unused.
+//
+// Returns:
+//   - err (error): ErrWriterClosed if the writer has been closed, or an error if rotating
+//     or writing the file fails.
+func (f *File) Write(data []byte, _ hqgologgerlevels.Level) (err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.closed {
+		err = ErrWriterClosed
+
+		return
+	}
+
+	line := data
+
+	if !f.cfg.DisableNewline {
+		f.buffer = append(f.buffer[:0], data...)
+		f.buffer = append(f.buffer, '\n')
+
+		line = f.buffer
+	}
+
+	now := time.Now()
+
+	rotateBySize := f.cfg.MaxSize > 0 && f.size > 0 && f.size+int64(len(line)) > f.cfg.MaxSize
+	rotateByTime := !f.next.IsZero() && !now.Before(f.next)
+
+	if rotateBySize || rotateByTime {
+		if err = f.rotate(now); err != nil {
+			return
+		}
+	}
+
+	n, err := f.file.Write(line)
+
+	f.size += int64(n)
+
+	return
+}
+
+// Flush commits the contents of the file to stable storage with fsync, so that messages
+// survive a crash of the operating system. The method is thread-safe.
+//
+// Returns:
+//   - err (error): ErrWriterClosed if the writer has been closed, or an error if
+//     syncing fails.
+func (f *File) Flush() (err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.closed {
+		err = ErrWriterClosed
+
+		return
+	}
+
+	err = f.file.Sync()
+
+	return
+}
+
+// Rotate forces a rotation of the file, regardless of its size or the rotation
+// interval. The method is thread-safe.
+//
+// Returns:
+//   - err (error): ErrWriterClosed if the writer has been closed, or an error if
+//     renaming the current file or opening the new one fails.
+func (f *File) Rotate() (err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.closed {
+		err = ErrWriterClosed
+
+		return
+	}
+
+	err = f.rotate(time.Now())
+
+	return
+}
+
+// Reopen reopens the file at the configured path without renaming it, closing the
+// previous file once the new one is open; if opening fails, writing continues to the
+// previous file. It is meant to be called after an external tool (e.g., logrotate with
+// the "create" method) has moved the file away, typically on SIGHUP; see ReopenOn. The
+// method is thread-safe.
+//
+// Returns:
+//   - err (error): ErrWriterClosed if the writer has been closed, or an error if opening
+//     the file or closing the previous one fails.
+func (f *File) Reopen() (err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.closed {
+		err = ErrWriterClosed
+
+		return
+	}
+
+	err = f.open(time.Now())
+
+	return
+}
+
+// ReopenOn starts a goroutine that calls Reopen whenever one of the given signals
+// (e.g., syscall.SIGHUP) is received. The returned function stops listening for the
+// signals and terminates the goroutine.
+//
+// Parameters:
+//   - signals (...os.Signal): The signals that trigger a reopen.
+//
+// Returns:
+//   - stop (func()): A function that stops reopening on the signals.
+func (f *File) ReopenOn(signals ...os.Signal) (stop func()) {
+	ch := make(chan os.Signal, 1)
+	done := make(chan struct{})
+
+	signal.Notify(ch, signals...)
+
+	go func() {
+		for {
+			select {
+			case <-ch:
+				_ = f.Reopen()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	once := &sync.Once{}
+
+	stop = func() {
+		once.Do(func() {
+			signal.Stop(ch)
+			close(done)
+		})
+	}
+
+	return
+}
+
+// Close closes the file and waits for any background compression and pruning of
+// backups to finish. Subsequent writes return ErrWriterClosed. The method is
+// thread-safe; closing an already closed writer is a no-op.
+//
+// Returns:
+//   - err (error): An error if closing the file fails.
+func (f *File) Close() (err error) {
+	f.mutex.Lock()
+
+	if f.closed {
+		f.mutex.Unlock()
+
+		return
+	}
+
+	f.closed = true
+
+	err = f.file.Close()
+
+	f.mutex.Unlock()
+
+	f.wg.Wait()
+
+	return
+}
+
+// rotate renames the current file to a backup named after now, opens a new file at the
+// configured path, closes the current file, and starts background compression and
+// pruning of backups. If the rename fails, logging continues to the current file; if
+// opening the new file fails, the backup is renamed back and logging continues to the
+// current file as well. If only closing the current file fails, the rotation still
+// completes and the error is returned. The caller must hold the mutex.
+//
+// Parameters:
+//   - now (time.Time): The time of the rotation.
+//
+// Returns:
+//   - err (error): An error if renaming, opening, or closing the file fails.
+func (f *File) rotate(now time.Time) (err error) {
+	backup := f.backupName(now)
+
+	renamed := true
+
+	if err = os.Rename(f.cfg.Path, backup); err != nil {
+		if !os.IsNotExist(err) {
+			return
+		}
+
+		renamed = false
+	}
+
+	previous := f.file
+
+	if err = f.open(now); err != nil && f.file == previous {
+		if renamed {
+			_ = os.Rename(backup, f.cfg.Path)
+		}
+
+		return
+	}
+
+	f.wg.Add(1)
+
+	go func() {
+		defer f.wg.Done()
+
+		f.mill.Lock()
+		defer f.mill.Unlock()
+
+		if f.cfg.Compress {
+			_ = compressFile(backup, f.cfg.FileMode)
+		}
+
+		f.prune(now)
+	}()
+
+	return
+}
+
+// open opens (or creates) the file at the configured path in append mode, replaces the
+// current file with it, and resets the size and next interval-based rotation time. The
+// current file, if any, is only closed once the new one is open, so it is kept if
+// opening fails. The caller must hold the mutex.
+//
+// Parameters:
+//   - now (time.Time): The current time, used to compute the next rotation.
+//
+// Returns:
+//   - err (error): An error if creating the directory or opening the file fails, or if
+//     closing the previous file fails (in which case the new file is still used).
+func (f *File) open(now time.Time) (err error) {
+	if err = os.MkdirAll(filepath.Dir(f.cfg.Path), 0o755); err != nil {
+		return
+	}
+
+	file, err := os.OpenFile(f.cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, f.cfg.FileMode)
+	if err != nil {
+		return
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return
+	}
+
+	previous := f.file
+
+	f.file = file
+	f.size = info.Size()
+
+	if f.cfg.RotationInterval > 0 {
+		f.next = now.Truncate(f.cfg.RotationInterval).Add(f.cfg.RotationInterval)
+	}
+
+	if previous != nil {
+		err = previous.Close()
+	}
+
+	return
+}
+
+// backupName returns the path of the backup created by a rotation at t, formed by
+// inserting the timestamp between the base name and the extension of the log file. If
+// a backup of the same time already exists, compressed or not (e.g., after two
+// rotations within a millisecond), a counter is appended to the timestamp (e.g.,
+// "app-2025-08-08T13-45-00.000-1.log"), so that no backup is overwritten.
+//
+// Parameters:
+//   - t (time.Time): The time of the rotation.
+//
+// Returns:
+//   - name (string): The path of the backup file.
+func (f *File) backupName(t time.Time) (name string) {
+	dir, prefix, ext := f.backupParts()
+
+	stamp := t.Format(backupTimeFormat)
+
+	for i := 0; ; i++ {
+		name = prefix + stamp
+
+		if i > 0 {
+			name += "-" + strconv.Itoa(i)
+		}
+
+		name = filepath.Join(dir, name+ext)
+
+		if !exists(name) && !exists(name+".gz") {
+			return
+		}
+	}
+}
+
+// backupParts splits the configured path into the directory, the backup name prefix
+// (base name without extension, followed by '-'), and the extension.
+//
+// Returns:
+//   - dir (string): The directory of the log file.
+//   - prefix (string): The prefix of backup file names.
+//   - ext (string): The extension of the log file, including the dot.
+func (f *File) backupParts() (dir, prefix, ext string) {
+	dir = filepath.Dir(f.cfg.Path)
+
+	base := filepath.Base(f.cfg.Path)
+
+	ext = filepath.Ext(base)
+
+	prefix = strings.TrimSuffix(base, ext) + "-"
+
+	return
+}
+
+// prune removes backups exceeding MaxBackups (oldest first) and backups older than
+// MaxAge. Errors are ignored, as pruning runs in the background and is retried on
+// the next rotation.
+//
+// Parameters:
+//   - now (time.Time): The current time, used to evaluate MaxAge.
+func (f *File) prune(now time.Time) {
+	if f.cfg.MaxBackups <= 0 && f.cfg.MaxAge <= 0 {
+		return
+	}
+
+	dir, prefix, ext := f.backupParts()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	type backup struct {
+		path  string
+		time  time.Time
+		count int
+	}
+
+	backups := make([]backup, 0, len(entries))
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		stamp := strings.TrimPrefix(name, prefix)
+
+		switch {
+		case strings.HasSuffix(stamp, ext+".gz"):
+			stamp = strings.TrimSuffix(stamp, ext+".gz")
+		case strings.HasSuffix(stamp, ext):
+			stamp = strings.TrimSuffix(stamp, ext)
+		default:
+			continue
+		}
+
+		count := 0
+
+		if len(stamp) > len(backupTimeFormat) {
+			suffix, ok := strings.CutPrefix(stamp[len(backupTimeFormat):], "-")
+			if !ok {
+				continue
+			}
+
+			if count, err = strconv.Atoi(suffix); err != nil || count < 1 {
+				continue
+			}
+
+			stamp = stamp[:len(backupTimeFormat)]
+		}
+
+		t, err := time.ParseInLocation(backupTimeFormat, stamp, time.Local)
+		if err != nil {
+			continue
+		}
+
+		backups = append(backups, backup{path: filepath.Join(dir, name), time: t, count: count})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		if backups[i].time.Equal(backups[j].time) {
+			return backups[i].count > backups[j].count
+		}
+
+		return backups[i].time.After(backups[j].time)
+	})
+
+	for i, b := range backups {
+		tooMany := f.cfg.MaxBackups > 0 && i >= f.cfg.MaxBackups
+		tooOld := f.cfg.MaxAge > 0 && now.Sub(b.time) > f.cfg.MaxAge
+
+		if tooMany || tooOld {
+			_ = os.Remove(b.path)
+		}
+	}
+}
+
+// FileWriterConfiguration defines configuration options for the File writer. It
+// controls the log file location, when the file is rotated, how many backups are
+// kept, and whether backups are compressed.
+//
+// Fields:
+//   - Path (string): The path of the log file. Its directory is created if missing.
+//   - MaxSize (int64): The maximum size of the log file in bytes before it is rotated.
+//     Zero disables size-based rotation.
+//   - RotationInterval (time.Duration): The interval at which the file is rotated,
+//     aligned to multiples of the interval since the zero time (e.g., time.Hour rotates
+//     at the top of every hour, 24 * time.Hour at midnight UTC). Zero disables
+//     interval-based rotation.
+//   - MaxBackups (int): The maximum number of backups to keep. Zero keeps all backups
+//     (subject to MaxAge).
+//   - MaxAge (time.Duration): The maximum age of backups, based on the time in their
+//     name. Zero keeps backups regardless of age (subject to MaxBackups).
+//   - Compress (bool): If true, backups are gzip-compressed in the background.
+//   - FileMode (os.FileMode): The permissions used when creating log files.
+//   - DisableNewline (bool): If true, prevents appending a newline character to
+//     each log message.
+type FileWriterConfiguration struct {
+	Path             string
+	MaxSize          int64
+	RotationInterval time.Duration
+	MaxBackups       int
+	MaxAge           time.Duration
+	Compress         bool
+	FileMode         os.FileMode
+	DisableNewline   bool
+}
+
+// backupTimeFormat is the layout of the rotation time embedded in backup file names.
+// It avoids ':' so that names are valid on all platforms.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+var (
+	_ Writer  = (*File)(nil)
+	_ Flusher = (*File)(nil)
+)
+
+// DefaultFileWriterConfig returns a default configuration for the File writer. The
+// default settings rotate the file at 100 MiB, keep all backups uncompressed, create
+// files with 0644 permissions, and append a newline to each message. The Path must be
+// set before use.
+//
+// Returns:
+//   - cfg (*FileWriterConfiguration): A pointer to the default configuration.
+func DefaultFileWriterConfig() (cfg *FileWriterConfiguration) {
+	cfg = &FileWriterConfiguration{
+		Path:             "",
+		MaxSize:          100 * 1024 * 1024,
+		RotationInterval: 0,
+		MaxBackups:       0,
+		MaxAge:           0,
+		Compress:         false,
+		FileMode:         0o644,
+		DisableNewline:   false,
+	}
+
+	return
+}
+
+// NewFileWriter creates and returns a new File writer, opening (or creating) the log
+// file at the configured path in append mode. If no configuration is provided (i.e.,
+// cfg is nil), it uses the default configuration from DefaultFileWriterConfig, in
+// which case opening fails because no path is set.
+//
+// Parameters:
+//   - cfg (*FileWriterConfiguration): The configuration for the writer. If nil,
+//     defaults are applied.
+//
+// Returns:
+//   - writer (*File): A pointer to a new File writer instance.
+//   - err (error): An error if the path is empty or the file cannot be opened.
+func NewFileWriter(cfg *FileWriterConfiguration) (writer *File, err error) {
+	if cfg == nil {
+		cfg = DefaultFileWriterConfig()
+	}
+
+	if cfg.Path == "" {
+		err = fmt.Errorf("%w: empty path", ErrInvalidConfiguration)
+
+		return
+	}
+
+	writer = &File{
+		mutex: &sync.Mutex{},
+		cfg:   cfg,
+		mill:  &sync.Mutex{},
+		wg:    &sync.WaitGroup{},
+	}
+
+	if err = writer.open(time.Now()); err != nil {
+		writer = nil
+
+		return
+	}
+
+	return
+}
+
+// compressFile gzip-compresses the file at path into path + ".gz" and removes the
+// original. The compressed data is written to a temporary file that is renamed into
+// place once complete, so a partially compressed backup is never left behind.
+//
+// Parameters:
+//   - path (string): The path of the file to compress.
+//   - mode (os.FileMode): The permissions of the compressed file.
+//
+// Returns:
+//   - err (error): An error if reading, compressing, closing, or renaming fails.
+func compressFile(path string, mode os.FileMode) (err error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return
+	}
+
+	tmp := path + ".gz.tmp"
+
+	dst, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		_ = src.Close()
+
+		return
+	}
+
+	gz := gzip.NewWriter(dst)
+
+	if _, err = io.Copy(gz, src); err == nil {
+		err = gz.Close()
+	}
+
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+
+	if closeErr := src.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp)
+
+		return
+	}
+
+	if err = os.Rename(tmp, path+".gz"); err != nil {
+		return
+	}
+
+	err = os.Remove(path)
+
+	return
+}
+
+// exists reports whether a file exists at path.
+//
+// Parameters:
+//   - path (string): The path to check.
+//
+// Returns:
+//   - found (bool): True if a file exists at path.
+func exists(path string) (found bool) {
+	_, err := os.Lstat(path)
+
+	found = err == nil
+
+	return
+}
@@ -0,0 +1,219 @@
+package writer_test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
+	hqgologgerwriter "github.com/hueristiq/hq-go-logger/writer"
+)
+
+func TestFileRotateKeepsEveryBackup(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cfg := hqgologgerwriter.DefaultFileWriterConfig()
+
+	cfg.Path = filepath.Join(dir, "app.log")
+
+	writer, err := hqgologgerwriter.NewFileWriter(cfg)
+	if err != nil {
+		t.Fatalf("NewFileWriter() error = %v", err)
+	}
+
+	const rotations = 5
+
+	for i := range rotations {
+		if err := writer.Write([]byte(strings.Repeat("x", i+1)), hqgologgerlevels.LevelInfo); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+
+		if err := writer.Rotate(); err != nil {
+			t.Fatalf("Rotate() error = %v", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	backups, err := filepath.Glob(filepath.Join(dir, "app-*.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(backups) != rotations {
+		t.Fatalf("got %d backups %v, want %d", len(backups), backups, rotations)
+	}
+
+	seen := make(map[string]bool)
+
+	for _, backup := range backups {
+		data, err := os.ReadFile(backup)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		seen[string(data)] = true
+	}
+
+	for i := range rotations {
+		if line := strings.Repeat("x", i+1) + "\n"; !seen[line] {
+			t.Errorf("no backup contains %q", line)
+		}
+	}
+}
+
+func TestFileRotatePrunesBackupsWithCounter(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cfg := hqgologgerwriter.DefaultFileWriterConfig()
+
+	cfg.Path = filepath.Join(dir, "app.log")
+	cfg.MaxBackups = 2
+
+	writer, err := hqgologgerwriter.NewFileWriter(cfg)
+	if err != nil {
+		t.Fatalf("NewFileWriter() error = %v", err)
+	}
+
+	for range 5 {
+		if err := writer.Rotate(); err != nil {
+			t.Fatalf("Rotate() error = %v", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	backups, err := filepath.Glob(filepath.Join(dir, "app-*.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(backups) != cfg.MaxBackups {
+		t.Errorf("got %d backups %v, want %d", len(backups), backups, cfg.MaxBackups)
+	}
+}
+
+func TestFileRotateCompressesBackup(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cfg := hqgologgerwriter.DefaultFileWriterConfig()
+
+	cfg.Path = filepath.Join(dir, "app.log")
+	cfg.Compress = true
+
+	writer, err := hqgologgerwriter.NewFileWriter(cfg)
+	if err != nil {
+		t.Fatalf("NewFileWriter() error = %v", err)
+	}
+
+	if err := writer.Write([]byte("rotated"), hqgologgerlevels.LevelInfo); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if err := writer.Rotate(); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if leftovers, _ := filepath.Glob(filepath.Join(dir, "app-*.log*")); len(leftovers) != 1 {
+		t.Fatalf("got backups %v, want a single compressed backup", leftovers)
+	}
+
+	backups, err := filepath.Glob(filepath.Join(dir, "app-*.log.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(backups) != 1 {
+		t.Fatalf("got compressed backups %v, want 1", backups)
+	}
+
+	file, err := os.Open(backups[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("reading the compressed backup: %v", err)
+	}
+
+	if got := string(data); got != "rotated\n" {
+		t.Errorf("compressed backup = %q, want %q", got, "rotated\n")
+	}
+}
+
+func TestFileFailedReopenKeepsFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cfg := hqgologgerwriter.DefaultFileWriterConfig()
+
+	cfg.Path = filepath.Join(dir, "logs", "app.log")
+
+	writer, err := hqgologgerwriter.NewFileWriter(cfg)
+	if err != nil {
+		t.Fatalf("NewFileWriter() error = %v", err)
+	}
+
+	defer writer.Close()
+
+	// Replace the directory of the log file with a regular file, so that the log file
+	// can neither be reopened nor rotated.
+	if err := os.Rename(filepath.Join(dir, "logs"), filepath.Join(dir, "moved")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "logs"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writer.Reopen(); err == nil {
+		t.Fatal("Reopen() error = nil, want an error")
+	}
+
+	if err := writer.Rotate(); err == nil {
+		t.Fatal("Rotate() error = nil, want an error")
+	}
+
+	if err := writer.Write([]byte("kept"), hqgologgerlevels.LevelInfo); err != nil {
+		t.Fatalf("Write() after failed reopen error = %v", err)
+	}
+
+	if err := writer.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "moved", "app.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "kept\n" {
+		t.Errorf("file contains %q, want %q", data, "kept\n")
+	}
+}
@@ -1,6 +1,7 @@
 package writer
 
 import (
+	"errors"
 	"io"
 
 	hqgologgerlevels "github.com/hueristiq/hq-go-logger/levels"
@@ -46,6 +47,25 @@ func (m *MultiWriter) Write(data []byte, level hqgologgerlevels.Level) (err erro
 	return
 }
 
+// Flush flushes all underlying writers that implement the Flusher interface. It attempts
+// to flush all of them, even if some fail, and returns the last non-nil error
+// encountered (if any).
+//
+// Returns:
+//   - err (error): The last non-nil error from any underlying writer, or nil if all
+//     flushes succeed.
+func (m *MultiWriter) Flush() (err error) {
+	for _, writer := range m.writers {
+		if flusher, ok := writer.(Flusher); ok {
+			if flushErr := flusher.Flush(); flushErr != nil {
+				err = flushErr
+			}
+		}
+	}
+
+	return
+}
+
 // Close closes all underlying writers in the MultiWriter's writers slice,
 // releasing their associated resources. It attempts to close all writers, even
 // if some fail, and returns the last non-nil error encountered (if any). This
@@ -78,7 +98,8 @@ func (m *MultiWriter) Close() (err error) {
 //     by a formatter (e.g., as JSON or plain text), and the level is a severity
 //     value from the levels package (e.g., LevelInfo, LevelError). Returns an error
 //     if the write operation fails (e.g., due to I/O issues or destination
-//     unavailability).
+//     unavailability). The logger reuses the data buffer once Write returns, so
+//     implementations that process data later (e.g., Async) must copy it.
 //   - Close() (err error): Closes the writer, releasing any associated resources
 //     (e.g., file handles or network connections). Returns an error if the close
 //     operation fails.
@@ -87,7 +108,21 @@ type Writer interface {
 	Write(data []byte, level hqgologgerlevels.Level) (err error)
 }
 
-var _ Writer = (*MultiWriter)(nil)
+// Flusher is an optional interface implemented by writers that buffer data. Loggers call
+// Flush before the program exits (e.g., after a fatal message) and on demand, so that no
+// buffered message is lost.
+//
+// Methods:
+//   - Flush() (err error): Blocks until all data accepted by Write has been delivered to
+//     the output destination. Returns an error if delivering the data fails.
+type Flusher interface {
+	Flush() (err error)
+}
+
+var (
+	_ Writer  = (*MultiWriter)(nil)
+	_ Flusher = (*MultiWriter)(nil)
+)
 
 // NewMultiWriter creates and returns a new MultiWriter instance that aggregates
 // the provided Writer instances. It filters out nil writers to ensure safe
@@ -115,3 +150,15 @@ func NewMultiWriter(writers ...Writer) (multi *MultiWriter) {
 
 	return
 }
+
+var (
+	// ErrWriterClosed is an error returned by writers when Write or another operation
+	// is called after the writer has been closed.
+	ErrWriterClosed = errors.New("writer closed")
+	// ErrInvalidConfiguration is an error returned by writer constructors when the
+	// provided configuration cannot be used (e.g., a file writer without a path).
+	ErrInvalidConfiguration = errors.New("invalid writer configuration")
+	// ErrCloseTimeout is an error returned by Close on buffering writers when pending
+	// messages could not be written within the configured timeout.
+	ErrCloseTimeout = errors.New("timed out writing pending messages")
+)